@@ -0,0 +1,83 @@
+// Package access defines the roles callers act with and what each role is
+// permitted to do.
+package access
+
+import (
+	"context"
+	"fmt"
+)
+
+type Role string
+
+const (
+	// RoleAnalyst runs searches and reads what they produced.
+	RoleAnalyst Role = "analyst"
+	// RoleAdmin can also manage brand profiles, schedules, notification
+	// channels and budgets, and read the audit log.
+	RoleAdmin Role = "admin"
+)
+
+// ParseRole returns the role called name. An empty name is an analyst.
+func ParseRole(name string) (Role, error) {
+	switch Role(name) {
+	case "", RoleAnalyst:
+		return RoleAnalyst, nil
+	case RoleAdmin:
+		return RoleAdmin, nil
+	default:
+		return "", fmt.Errorf("unknown role %q, expected %s or %s", name, RoleAnalyst, RoleAdmin)
+	}
+}
+
+type Permission string
+
+const (
+	ReadData            Permission = "data:read"
+	RunSearches         Permission = "searches:run"
+	CancelJobs          Permission = "jobs:cancel"
+	ManageGroups        Permission = "groups:manage"
+	ManageBrands        Permission = "brands:manage"
+	ManageSchedules     Permission = "schedules:manage"
+	ManageNotifications Permission = "notifications:manage"
+	ManageBudgets       Permission = "budgets:manage"
+	ReadAudit           Permission = "audit:read"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAnalyst: {ReadData, RunSearches, CancelJobs, ManageGroups},
+	RoleAdmin: {
+		ReadData, RunSearches, CancelJobs, ManageGroups,
+		ManageBrands, ManageSchedules, ManageNotifications, ManageBudgets, ReadAudit,
+	},
+}
+
+// Can reports whether role has permission.
+func Can(role Role, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Identity is who a request is made by. Actor names the API key or token
+// subject for the audit log.
+type Identity struct {
+	Actor string
+	Role  Role
+}
+
+type contextKey struct{}
+
+// WithIdentity returns a context carrying the identity of the caller.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity of the caller; it is the zero Identity,
+// which has no permissions, when the request was not authenticated.
+func FromContext(ctx context.Context) Identity {
+	id, _ := ctx.Value(contextKey{}).(Identity)
+	return id
+}
@@ -0,0 +1,129 @@
+// Package audit keeps the log of the changes callers made through the API:
+// who sent which request, with what payload, and how it was answered.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/access"
+)
+
+const auditCollection = "audit_log"
+
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+// Entry is one request that could change something.
+type Entry struct {
+	ID       primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Tenant   string             `json:"tenant" bson:"tenant"`
+	Actor    string             `json:"actor" bson:"actor"`
+	Role     access.Role        `json:"role" bson:"role"`
+	Method   string             `json:"method" bson:"method"`
+	Path     string             `json:"path" bson:"path"`
+	Query    string             `json:"query,omitempty" bson:"query,omitempty"`
+	Status   int                `json:"status" bson:"status"`
+	Request  string             `json:"request,omitempty" bson:"request,omitempty"`
+	ClientIP string             `json:"client_ip" bson:"client_ip"`
+	At       time.Time          `json:"at" bson:"at"`
+}
+
+// Filter narrows the entries returned by Entries. Zero values are ignored,
+// except for Tenant which always applies. Path matches a prefix.
+type Filter struct {
+	Tenant string
+	Actor  string
+	Method string
+	Path   string
+	From   time.Time
+	To     time.Time
+	Limit  int
+}
+
+// Log stores audit entries in MongoDB.
+type Log struct {
+	collection *mongo.Collection
+}
+
+func NewLog(client *mongo.Client, dbName string) *Log {
+	return &Log{collection: client.Database(dbName).Collection(auditCollection)}
+}
+
+func (l *Log) EnsureIndexes(ctx context.Context) error {
+	_, err := l.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "at", Value: -1}}},
+		{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "actor", Value: 1}, {Key: "at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", auditCollection, err)
+	}
+	return nil
+}
+
+// Record stores entry and fills in its ID.
+func (l *Log) Record(ctx context.Context, entry *Entry) error {
+	entry.ID = primitive.NewObjectID()
+	if entry.At.IsZero() {
+		entry.At = time.Now().UTC()
+	}
+	if _, err := l.collection.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("failed to insert audit entry: %w", err)
+	}
+	return nil
+}
+
+// Entries returns the entries matching f, most recent first.
+func (l *Log) Entries(ctx context.Context, f Filter) ([]Entry, error) {
+	filter := bson.M{"tenant": f.Tenant}
+	if f.Actor != "" {
+		filter["actor"] = f.Actor
+	}
+	if f.Method != "" {
+		filter["method"] = f.Method
+	}
+	if f.Path != "" {
+		filter["path"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(f.Path)}
+	}
+	if !f.From.IsZero() || !f.To.IsZero() {
+		at := bson.M{}
+		if !f.From.IsZero() {
+			at["$gte"] = f.From
+		}
+		if !f.To.IsZero() {
+			at["$lte"] = f.To
+		}
+		filter["at"] = at
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = DefaultLimit
+	}
+	if f.Limit > MaxLimit {
+		f.Limit = MaxLimit
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(f.Limit))
+
+	cursor, err := l.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find audit entries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode audit entries: %w", err)
+	}
+	return entries, nil
+}
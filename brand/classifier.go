@@ -0,0 +1,160 @@
+package brand
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"google-monitoring/domains"
+	"google-monitoring/providers"
+)
+
+// Label is the verdict on an advertiser.
+type Label string
+
+const (
+	// LabelOwned marks ads from the brand's own domains.
+	LabelOwned Label = "owned"
+	// LabelAuthorized marks ads from authorized resellers.
+	LabelAuthorized Label = "authorized"
+	// LabelInfringing marks anyone else bidding on a brand term or using one
+	// in the ad copy.
+	LabelInfringing Label = "infringing"
+	// LabelUnrelated marks ads with no link to the brand, e.g. other
+	// advertisers on a generic query.
+	LabelUnrelated Label = "unrelated"
+)
+
+type Verdict struct {
+	Label  Label  `json:"label" bson:"label"`
+	Reason string `json:"reason,omitempty" bson:"reason,omitempty"`
+}
+
+// Advertiser summarises where an infringing advertiser was seen in a run.
+type Advertiser struct {
+	Domain string   `json:"domain" bson:"domain"`
+	Ads    int      `json:"ads" bson:"ads"`
+	Cities []string `json:"cities" bson:"cities"`
+}
+
+// Classify labels an ad shown for query. Organic results get no verdict.
+func (p *Profile) Classify(query string, result providers.SerpResult) *Verdict {
+	if result.Kind != providers.KindAd {
+		return nil
+	}
+
+	// Profiles may list a subdomain, so the landing host is checked as well
+	// as the registrable advertiser domain.
+	hosts := []string{domains.Host(domains.Unwrap(result.Link)), strings.ToLower(result.AdvertiserDomain)}
+	switch {
+	case matchesDomain(hosts, p.OwnedDomains):
+		return &Verdict{Label: LabelOwned}
+	case matchesDomain(hosts, p.AuthorizedDomains):
+		return &Verdict{Label: LabelAuthorized}
+	}
+
+	if term := p.matchTerm(query); term != "" {
+		return &Verdict{Label: LabelInfringing, Reason: "bids on brand term \"" + term + "\""}
+	}
+	if term := p.matchTerm(result.Title + " " + result.Description); term != "" {
+		return &Verdict{Label: LabelInfringing, Reason: "uses brand term \"" + term + "\" in ad copy"}
+	}
+	return &Verdict{Label: LabelUnrelated}
+}
+
+// matchesDomain reports whether any of hosts is one of list or a subdomain
+// of one.
+func matchesDomain(hosts, list []string) bool {
+	for _, d := range list {
+		d = strings.TrimPrefix(strings.ToLower(d), "www.")
+		for _, host := range hosts {
+			if host != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchTerm returns the first brand term found in text as whole words,
+// ignoring case and accents, so that "oi" matches "Oi Fibra" but not
+// "noite".
+func (p *Profile) matchTerm(text string) string {
+	textWords := words(text)
+	for _, term := range p.Terms {
+		if termWords := words(term); len(termWords) > 0 && containsWords(textWords, termWords) {
+			return term
+		}
+	}
+	return ""
+}
+
+// words splits the folded s on everything but letters and digits.
+func words(s string) []string {
+	return strings.FieldsFunc(fold(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// containsWords reports whether sub appears in list as consecutive words.
+func containsWords(list, sub []string) bool {
+	for i := 0; i+len(sub) <= len(list); i++ {
+		if slices.Equal(list[i:i+len(sub)], sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// Collector gathers the infringing advertisers of a run across cities.
+type Collector struct {
+	byDomain map[string]*Advertiser
+}
+
+func (c *Collector) Add(city, domain string) {
+	if c.byDomain == nil {
+		c.byDomain = map[string]*Advertiser{}
+	}
+
+	advertiser, ok := c.byDomain[domain]
+	if !ok {
+		advertiser = &Advertiser{Domain: domain}
+		c.byDomain[domain] = advertiser
+	}
+	advertiser.Ads++
+	for _, seen := range advertiser.Cities {
+		if seen == city {
+			return
+		}
+	}
+	advertiser.Cities = append(advertiser.Cities, city)
+}
+
+// Advertisers returns the collected advertisers, most ads first.
+func (c *Collector) Advertisers() []Advertiser {
+	list := make([]Advertiser, 0, len(c.byDomain))
+	for _, advertiser := range c.byDomain {
+		list = append(list, *advertiser)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Ads != list[j].Ads {
+			return list[i].Ads > list[j].Ads
+		}
+		return list[i].Domain < list[j].Domain
+	})
+	return list
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "ê", "e", "è", "e", "ë", "e",
+	"í", "i", "î", "i", "ì", "i", "ï", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ò", "o", "ö", "o",
+	"ú", "u", "û", "u", "ù", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+func fold(s string) string {
+	return accents.Replace(strings.ToLower(s))
+}
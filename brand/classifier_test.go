@@ -0,0 +1,75 @@
+package brand
+
+import (
+	"testing"
+
+	"google-monitoring/domains"
+	"google-monitoring/providers"
+)
+
+// adTo is an ad linking to link, with its advertiser worked out as the
+// SerpAPI parser does.
+func adTo(link string) providers.SerpResult {
+	return providers.SerpResult{Kind: providers.KindAd, Link: link, AdvertiserDomain: domains.Advertiser(link)}
+}
+
+func TestMatchTerm(t *testing.T) {
+	p := &Profile{Terms: []string{"Oi", "Passada Run", "coca-cola"}}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Oi Fibra: internet rápida", "Oi"},
+		{"Planos da OI.", "Oi"},
+		{"Óí fibra", "Oi"},
+		{"Promoção da noite", ""},
+		{"Uma coisa boa", ""},
+		{"Tênis Passada Run em oferta", "Passada Run"},
+		{"passada-run oficial", "Passada Run"},
+		{"Passada para run", ""},
+		{"Passadas Run", ""},
+		{"Coca Cola gelada", "coca-cola"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := p.matchTerm(tt.text); got != tt.want {
+			t.Errorf("matchTerm(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	p := &Profile{
+		OwnedDomains:      []string{"oi.com.br"},
+		AuthorizedDomains: []string{"revenda.com.br"},
+		Terms:             []string{"oi"},
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		result providers.SerpResult
+		want   Label
+	}{
+		{"owned", "oi fibra", providers.SerpResult{Kind: providers.KindAd, Link: "https://www.oi.com.br/fibra", AdvertiserDomain: "oi.com.br"}, LabelOwned},
+		{"authorized", "oi fibra", providers.SerpResult{Kind: providers.KindAd, Link: "https://loja.revenda.com.br/", AdvertiserDomain: "revenda.com.br"}, LabelAuthorized},
+		{"bids on term", "oi fibra", providers.SerpResult{Kind: providers.KindAd, Link: "https://outra.com.br/", AdvertiserDomain: "outra.com.br"}, LabelInfringing},
+		{"term in copy", "internet fibra", providers.SerpResult{Kind: providers.KindAd, Title: "Melhor que a Oi", AdvertiserDomain: "outra.com.br"}, LabelInfringing},
+		{"term inside a word", "internet a noite", providers.SerpResult{Kind: providers.KindAd, Title: "Qualquer coisa", Description: "Dia e noite", AdvertiserDomain: "outra.com.br"}, LabelUnrelated},
+		{"owned through a google click", "oi fibra", adTo("https://www.googleadservices.com/pagead/aclk?adurl=https%3A%2F%2Fwww.oi.com.br%2F"), LabelOwned},
+		{"redirect on its own site", "oi fibra", adTo("https://outra.com.br/redirect?url=https%3A%2F%2Fwww.oi.com.br%2F"), LabelInfringing},
+		{"fake google path", "oi fibra", adTo("https://outra.com.br/url?q=https%3A%2F%2Fwww.oi.com.br%2F"), LabelInfringing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Classify(tt.query, tt.result); got == nil || got.Label != tt.want {
+				t.Errorf("Classify = %+v, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if got := p.Classify("oi", providers.SerpResult{Kind: providers.KindOrganic}); got != nil {
+		t.Errorf("Classify(organic) = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,183 @@
+package brand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const profilesCollection = "brand_profiles"
+
+var ErrNotFound = errors.New("brand profile not found")
+
+// ValidationError reports an invalid brand profile.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Profile describes a brand being protected: the domains it advertises from,
+// the resellers allowed to bid on it, and the terms that identify it.
+type Profile struct {
+	ID                primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Tenant            string             `json:"-" bson:"tenant"`
+	Name              string             `json:"name" bson:"name"`
+	OwnedDomains      []string           `json:"owned_domains" bson:"owned_domains"`
+	AuthorizedDomains []string           `json:"authorized_domains" bson:"authorized_domains"`
+	Terms             []string           `json:"terms" bson:"terms"`
+	CreatedAt         time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// Validate checks p and lower-cases its domains.
+func (p *Profile) Validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+	if len(p.OwnedDomains) == 0 {
+		return &ValidationError{Field: "owned_domains", Message: "at least one domain is required"}
+	}
+	if len(p.Terms) == 0 {
+		return &ValidationError{Field: "terms", Message: "at least one brand term is required"}
+	}
+
+	for _, domains := range [][]string{p.OwnedDomains, p.AuthorizedDomains} {
+		for i, domain := range domains {
+			domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+			if domain == "" || strings.ContainsAny(domain, "/: ") {
+				return &ValidationError{Field: "domains", Message: fmt.Sprintf("invalid domain %q", domains[i])}
+			}
+			domains[i] = domain
+		}
+	}
+	return nil
+}
+
+// Store persists brand profiles in MongoDB. Every profile belongs to a
+// tenant, and profile names are unique within a tenant.
+type Store struct {
+	collection *mongo.Collection
+}
+
+func NewStore(client *mongo.Client, dbName string) *Store {
+	return &Store{collection: client.Database(dbName).Collection(profilesCollection)}
+}
+
+func (st *Store) EnsureIndexes(ctx context.Context) error {
+	// Names used to be unique across the whole deployment.
+	_, err := st.collection.Indexes().DropOne(ctx, "name_1")
+	var cmdErr mongo.CommandError
+	if err != nil && !(errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27)) {
+		return fmt.Errorf("failed to drop the name index on %s: %w", profilesCollection, err)
+	}
+
+	_, err = st.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tenant", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", profilesCollection, err)
+	}
+	return nil
+}
+
+func (st *Store) List(ctx context.Context, tenant string) ([]Profile, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := st.collection.Find(ctx, bson.M{"tenant": tenant}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find brand profiles: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	list := []Profile{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode brand profiles: %w", err)
+	}
+	return list, nil
+}
+
+func (st *Store) Get(ctx context.Context, tenant, name string) (*Profile, error) {
+	var p Profile
+	err := st.collection.FindOne(ctx, bson.M{"tenant": tenant, "name": name}).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find brand profile %q: %w", name, err)
+	}
+	return &p, nil
+}
+
+func (st *Store) Create(ctx context.Context, tenant string, p *Profile) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	p.ID = primitive.NewObjectID()
+	p.Tenant = tenant
+	p.CreatedAt = now
+	p.UpdatedAt = now
+
+	_, err := st.collection.InsertOne(ctx, p)
+	if mongo.IsDuplicateKeyError(err) {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("a brand profile named %q already exists", p.Name)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert brand profile: %w", err)
+	}
+	return nil
+}
+
+// Update replaces the profile called name with p, which may rename it.
+func (st *Store) Update(ctx context.Context, tenant, name string, p *Profile) (*Profile, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"name":               p.Name,
+		"owned_domains":      p.OwnedDomains,
+		"authorized_domains": p.AuthorizedDomains,
+		"terms":              p.Terms,
+		"updated_at":         time.Now().UTC(),
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated Profile
+	err := st.collection.FindOneAndUpdate(ctx, bson.M{"tenant": tenant, "name": name}, update, opts).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, &ValidationError{Field: "name", Message: fmt.Sprintf("a brand profile named %q already exists", p.Name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update brand profile %q: %w", name, err)
+	}
+	return &updated, nil
+}
+
+func (st *Store) Delete(ctx context.Context, tenant, name string) error {
+	result, err := st.collection.DeleteOne(ctx, bson.M{"tenant": tenant, "name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete brand profile %q: %w", name, err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Package changes detects how the ads shown for a query in a city changed
+// from one run to the next.
+package changes
+
+import (
+	"sort"
+
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+// Diff compares the ads of current with those of previous and returns the
+// changes, ordered by advertiser. A nil previous observation is a first
+// sighting and yields no changes.
+func Diff(previous, current *store.Observation) []store.AdChange {
+	if previous == nil || current == nil {
+		return nil
+	}
+
+	before := creatives(previous.Results)
+	after := creatives(current.Results)
+
+	change := func(advertiser string, t store.ChangeType, was, is string) store.AdChange {
+		return store.AdChange{
+			RunID:         current.RunID,
+			PreviousRunID: previous.RunID,
+			Tenant:        current.Tenant,
+			Query:         current.Query,
+			City:          current.City,
+			Device:        current.Device,
+			Advertiser:    advertiser,
+			Type:          t,
+			Before:        was,
+			After:         is,
+			DetectedAt:    current.ObservedAt,
+		}
+	}
+
+	var changes []store.AdChange
+	for _, advertiser := range advertisers(before, after) {
+		old, hadAd := before[advertiser]
+		ad, hasAd := after[advertiser]
+
+		switch {
+		case !hadAd:
+			changes = append(changes, change(advertiser, store.ChangeNewAdvertiser, "", ad.Title))
+		case !hasAd:
+			changes = append(changes, change(advertiser, store.ChangeDisappearedAdvertiser, old.Title, ""))
+		default:
+			if old.Title != ad.Title {
+				changes = append(changes, change(advertiser, store.ChangeHeadline, old.Title, ad.Title))
+			}
+			if old.Description != ad.Description {
+				changes = append(changes, change(advertiser, store.ChangeDescription, old.Description, ad.Description))
+			}
+			if old.Link != ad.Link {
+				changes = append(changes, change(advertiser, store.ChangeLandingURL, old.Link, ad.Link))
+			}
+		}
+	}
+	return changes
+}
+
+// creatives picks the ad every advertiser in results is judged by: its best
+// placed one, top of page before bottom, then by position.
+func creatives(results []store.Result) map[string]providers.SerpResult {
+	byAdvertiser := map[string]providers.SerpResult{}
+	for _, result := range results {
+		serp := result.Serp
+		if serp.Kind != providers.KindAd || serp.AdvertiserDomain == "" {
+			continue
+		}
+		if best, ok := byAdvertiser[serp.AdvertiserDomain]; ok && !placedBefore(serp, best) {
+			continue
+		}
+		byAdvertiser[serp.AdvertiserDomain] = serp
+	}
+	return byAdvertiser
+}
+
+func placedBefore(a, b providers.SerpResult) bool {
+	if a.Block != b.Block {
+		return a.Block == providers.BlockTop
+	}
+	return a.Position < b.Position
+}
+
+// advertisers returns the advertisers of both sets, sorted.
+func advertisers(sets ...map[string]providers.SerpResult) []string {
+	seen := map[string]bool{}
+	var list []string
+	for _, set := range sets {
+		for advertiser := range set {
+			if !seen[advertiser] {
+				seen[advertiser] = true
+				list = append(list, advertiser)
+			}
+		}
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,181 @@
+package changes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+func ad(advertiser, block string, position int, title, description, link string) store.Result {
+	return store.Result{Serp: providers.SerpResult{
+		Kind:             providers.KindAd,
+		AdvertiserDomain: advertiser,
+		Block:            block,
+		Position:         position,
+		Title:            title,
+		Description:      description,
+		Link:             link,
+	}}
+}
+
+func organic(domain, title string) store.Result {
+	return store.Result{Serp: providers.SerpResult{Kind: providers.KindOrganic, AdvertiserDomain: domain, Title: title}}
+}
+
+func observation(results ...store.Result) *store.Observation {
+	return &store.Observation{
+		RunID:      primitive.NewObjectID(),
+		Tenant:     "acme",
+		Query:      "tenis corrida",
+		City:       "Jau,State of Sao Paulo,Brazil",
+		Device:     "desktop",
+		ObservedAt: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+		Results:    results,
+	}
+}
+
+// change is the part of an AdChange that depends on the ads compared.
+type change struct {
+	Advertiser string
+	Type       store.ChangeType
+	Before     string
+	After      string
+}
+
+func TestDiff(t *testing.T) {
+	const (
+		top    = providers.BlockTop
+		bottom = providers.BlockBottom
+	)
+
+	tests := []struct {
+		name     string
+		previous []store.Result
+		current  []store.Result
+		want     []change
+	}{
+		{
+			name:     "unchanged",
+			previous: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			current:  []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+		},
+		{
+			name:    "new advertiser",
+			current: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			want:    []change{{"loja.com", store.ChangeNewAdvertiser, "", "Tênis"}},
+		},
+		{
+			name:     "disappeared advertiser",
+			previous: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			want:     []change{{"loja.com", store.ChangeDisappearedAdvertiser, "Tênis", ""}},
+		},
+		{
+			name:     "headline",
+			previous: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			current:  []store.Result{ad("loja.com", top, 1, "Tênis em promoção", "Frete grátis", "https://loja.com/")},
+			want:     []change{{"loja.com", store.ChangeHeadline, "Tênis", "Tênis em promoção"}},
+		},
+		{
+			name:     "description",
+			previous: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			current:  []store.Result{ad("loja.com", top, 1, "Tênis", "Até 10x sem juros", "https://loja.com/")},
+			want:     []change{{"loja.com", store.ChangeDescription, "Frete grátis", "Até 10x sem juros"}},
+		},
+		{
+			name:     "landing url",
+			previous: []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/")},
+			current:  []store.Result{ad("loja.com", top, 1, "Tênis", "Frete grátis", "https://loja.com/corrida")},
+			want:     []change{{"loja.com", store.ChangeLandingURL, "https://loja.com/", "https://loja.com/corrida"}},
+		},
+		{
+			name:     "every field of one ad",
+			previous: []store.Result{ad("loja.com", top, 1, "A", "B", "https://loja.com/c")},
+			current:  []store.Result{ad("loja.com", top, 1, "X", "Y", "https://loja.com/z")},
+			want: []change{
+				{"loja.com", store.ChangeHeadline, "A", "X"},
+				{"loja.com", store.ChangeDescription, "B", "Y"},
+				{"loja.com", store.ChangeLandingURL, "https://loja.com/c", "https://loja.com/z"},
+			},
+		},
+		{
+			name: "ordered by advertiser",
+			previous: []store.Result{
+				ad("zeta.com", top, 1, "Zeta", "", ""),
+				ad("beta.com", top, 2, "Beta", "", ""),
+			},
+			current: []store.Result{
+				ad("alfa.com", top, 1, "Alfa", "", ""),
+				ad("beta.com", top, 2, "Beta 2", "", ""),
+			},
+			want: []change{
+				{"alfa.com", store.ChangeNewAdvertiser, "", "Alfa"},
+				{"beta.com", store.ChangeHeadline, "Beta", "Beta 2"},
+				{"zeta.com", store.ChangeDisappearedAdvertiser, "Zeta", ""},
+			},
+		},
+		{
+			name:     "organic results and ads without advertiser are ignored",
+			previous: []store.Result{organic("loja.com", "Loja")},
+			current:  []store.Result{organic("loja.com", "Loja nova"), ad("", top, 1, "Sem anunciante", "", "")},
+		},
+		{
+			name: "top of page creative wins over a better bottom position",
+			previous: []store.Result{
+				ad("loja.com", bottom, 1, "Rodapé", "", ""),
+				ad("loja.com", top, 3, "Topo", "", ""),
+			},
+			current: []store.Result{
+				ad("loja.com", top, 3, "Topo", "", ""),
+				ad("loja.com", bottom, 1, "Rodapé novo", "", ""),
+			},
+		},
+		{
+			name: "best position within a block",
+			previous: []store.Result{
+				ad("loja.com", top, 2, "Segundo", "", ""),
+				ad("loja.com", top, 1, "Primeiro", "", ""),
+			},
+			current: []store.Result{
+				ad("loja.com", top, 1, "Primeiro novo", "", ""),
+				ad("loja.com", top, 2, "Segundo", "", ""),
+			},
+			want: []change{{"loja.com", store.ChangeHeadline, "Primeiro", "Primeiro novo"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, current := observation(tt.previous...), observation(tt.current...)
+
+			var got []change
+			for _, c := range Diff(previous, current) {
+				got = append(got, change{c.Advertiser, c.Type, c.Before, c.After})
+
+				if c.RunID != current.RunID || c.PreviousRunID != previous.RunID {
+					t.Errorf("%s: runs = %s after %s, want %s after %s", c.Type, c.RunID.Hex(), c.PreviousRunID.Hex(), current.RunID.Hex(), previous.RunID.Hex())
+				}
+				if c.Tenant != current.Tenant || c.Query != current.Query || c.City != current.City || c.Device != current.Device || !c.DetectedAt.Equal(current.ObservedAt) {
+					t.Errorf("%s: change %+v does not describe the current observation", c.Type, c)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffWithoutPreviousObservation(t *testing.T) {
+	current := observation(ad("loja.com", providers.BlockTop, 1, "Tênis", "", ""))
+	if got := Diff(nil, current); got != nil {
+		t.Errorf("Diff(nil, current) = %+v, want no changes for a first sighting", got)
+	}
+	if got := Diff(current, nil); got != nil {
+		t.Errorf("Diff(previous, nil) = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,174 @@
+package cities
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{"ibge_code", "name", "uf", "location", "population", "latitude", "longitude", "capital"}
+
+// Parse reads a catalogue in the format of the embedded cities.csv. Empty
+// numeric columns are read as zero.
+func Parse(r io.Reader) ([]City, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	if strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+		return nil, fmt.Errorf("unexpected header %q", strings.Join(header, ","))
+	}
+
+	var list []City
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		city, err := parseRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		list = append(list, city)
+	}
+	return list, nil
+}
+
+func parseRecord(record []string) (City, error) {
+	city := City{
+		Name:     record[1],
+		UF:       record[2],
+		Location: record[3],
+		State:    states[record[2]].Name,
+	}
+
+	var err error
+	if city.IBGECode, err = parseOptionalInt(record[0]); err != nil {
+		return city, fmt.Errorf("ibge_code: %w", err)
+	}
+	if city.Population, err = parseOptionalInt(record[4]); err != nil {
+		return city, fmt.Errorf("population: %w", err)
+	}
+	if city.Latitude, err = parseOptionalFloat(record[5]); err != nil {
+		return city, fmt.Errorf("latitude: %w", err)
+	}
+	if city.Longitude, err = parseOptionalFloat(record[6]); err != nil {
+		return city, fmt.Errorf("longitude: %w", err)
+	}
+
+	switch record[7] {
+	case "":
+	case "true":
+		city.Capital = true
+	default:
+		return city, fmt.Errorf("capital: expected \"true\" or empty, got %q", record[7])
+	}
+
+	return city, nil
+}
+
+func parseOptionalInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func parseOptionalFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// Validate reports every malformed or duplicated entry of list.
+func Validate(list []City) error {
+	var errs []error
+	fail := func(city City, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s (%s): %s", city.Name, city.UF, fmt.Sprintf(format, args...)))
+	}
+
+	locations := map[string]bool{}
+	names := map[string]bool{}
+	codes := map[int]bool{}
+	capitals := map[string]string{}
+
+	for _, city := range list {
+		st, ok := states[city.UF]
+		if !ok {
+			fail(city, "unknown uf %q", city.UF)
+			continue
+		}
+
+		if city.Name == "" || city.Name != strings.TrimSpace(city.Name) {
+			fail(city, "name is empty or padded")
+		}
+
+		// SerpAPI locations are "Name,State,Brazil" or "Name,Name,State,Brazil".
+		suffix := "," + st.Location + ",Brazil"
+		if !strings.HasPrefix(city.Location, city.Name+",") || !strings.HasSuffix(city.Location, suffix) {
+			fail(city, "location %q does not match name and state", city.Location)
+		}
+
+		if city.IBGECode != 0 && (city.IBGECode < 1000000 || city.IBGECode > 9999999 || city.IBGECode/100000 != st.Code) {
+			fail(city, "ibge_code %d is not a 7-digit code of state %d", city.IBGECode, st.Code)
+		}
+		if city.Population < 0 {
+			fail(city, "negative population")
+		}
+		// The IBGE columns are only filled in for part of the catalogue, but
+		// a row has either all of them or none, and capitals have them all.
+		filled := city.IBGECode != 0 && city.Population != 0 && (city.Latitude != 0 || city.Longitude != 0)
+		empty := city.IBGECode == 0 && city.Population == 0 && city.Latitude == 0 && city.Longitude == 0
+		switch {
+		case !filled && !empty:
+			fail(city, "ibge_code, population and coordinates must be filled in together")
+		case city.Capital && !filled:
+			fail(city, "capital without ibge_code, population and coordinates")
+		}
+		if city.Latitude < -34 || city.Latitude > 6 || city.Longitude < -74 || city.Longitude > -28 {
+			if city.Latitude != 0 || city.Longitude != 0 {
+				fail(city, "coordinates %v,%v are outside Brazil", city.Latitude, city.Longitude)
+			}
+		}
+
+		location := strings.ToLower(city.Location)
+		if locations[location] {
+			fail(city, "duplicate location %q", city.Location)
+		}
+		locations[location] = true
+
+		name := fold(city.Name) + "/" + city.UF
+		if names[name] {
+			fail(city, "duplicate city")
+		}
+		names[name] = true
+
+		if city.IBGECode != 0 {
+			if codes[city.IBGECode] {
+				fail(city, "duplicate ibge_code %d", city.IBGECode)
+			}
+			codes[city.IBGECode] = true
+		}
+
+		if city.Capital {
+			if other, ok := capitals[city.UF]; ok {
+				fail(city, "second capital of %s after %s", city.UF, other)
+			}
+			capitals[city.UF] = city.Name
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -1,4320 +1,212 @@
 package cities
 
-var cities = [...]string{
-	"Rio Branco,State of Acre,Brazil",
-	"Capela,State of Alagoas,Brazil",
-	"Maceio,State of Alagoas,Brazil",
-	"Matriz de Camaragibe,State of Alagoas,Brazil",
-	"Itacoatiara,State of Amazonas,Brazil",
-	"Manaus,State of Amazonas,Brazil",
-	"Tefe,State of Amazonas,Brazil",
-	"Macapa,State of Amapa,Brazil",
-	"Alagoinhas,State of Bahia,Brazil",
-	"Barreiras,State of Bahia,Brazil",
-	"Caetite,State of Bahia,Brazil",
-	"Eunapolis,State of Bahia,Brazil",
-	"Feira de Santana,State of Bahia,Brazil",
-	"Guanambi,State of Bahia,Brazil",
-	"Itabuna,State of Bahia,Brazil",
-	"Itamaraju,State of Bahia,Brazil",
-	"Itapetinga,State of Bahia,Brazil",
-	"Jequie,State of Bahia,Brazil",
-	"Juazeiro,State of Bahia,Brazil",
-	"Salvador,State of Bahia,Brazil",
-	"Santo Antonio de Jesus,State of Bahia,Brazil",
-	"Simoes Filho,State of Bahia,Brazil",
-	"Vitoria da Conquista,State of Bahia,Brazil",
-	"Caninde,State of Ceara,Brazil",
-	"Fortaleza,State of Ceara,Brazil",
-	"Juazeiro do Norte,State of Ceara,Brazil",
-	"Sobral,State of Ceara,Brazil",
-	"Brasilia,Federal District,Brazil",
-	"Cachoeiro de Itapemirim,State of Espirito Santo,Brazil",
-	"Castelo,State of Espirito Santo,Brazil",
-	"Colatina,State of Espirito Santo,Brazil",
-	"Linhares,State of Espirito Santo,Brazil",
-	"Sao Mateus,State of Espirito Santo,Brazil",
-	"Serra,State of Espirito Santo,Brazil",
-	"Vila Velha,State of Espirito Santo,Brazil",
-	"Vitoria,State of Espirito Santo,Brazil",
-	"Catalao,State of Goias,Brazil",
-	"Ceres,State of Goias,Brazil",
-	"Goiania,State of Goias,Brazil",
-	"Itumbiara,State of Goias,Brazil",
-	"Jatai,State of Goias,Brazil",
-	"Rio Verde,State of Goias,Brazil",
-	"Trindade,State of Goias,Brazil",
-	"Balsas,State of Maranhao,Brazil",
-	"Imperatriz,State of Maranhao,Brazil",
-	"Sao Luis,State of Maranhao,Brazil",
-	"Alfenas,State of Minas Gerais,Brazil",
-	"Araxa,State of Minas Gerais,Brazil",
-	"Arcos,State of Minas Gerais,Brazil",
-	"Barbacena,State of Minas Gerais,Brazil",
-	"Belo Horizonte,State of Minas Gerais,Brazil",
-	"Caceres,State of Mato Grosso,Brazil",
-	"Cataguases,State of Minas Gerais,Brazil",
-	"Divinopolis,State of Minas Gerais,Brazil",
-	"Governador Valadares,State of Minas Gerais,Brazil",
-	"Ipatinga,State of Minas Gerais,Brazil",
-	"Itajuba,State of Minas Gerais,Brazil",
-	"Juatuba,State of Minas Gerais,Brazil",
-	"Juiz de Fora,State of Minas Gerais,Brazil",
-	"Lavras,State of Minas Gerais,Brazil",
-	"Manhuacu,State of Minas Gerais,Brazil",
-	"Monte Carmelo,State of Minas Gerais,Brazil",
-	"Montes Claros,State of Minas Gerais,Brazil",
-	"Ouro Preto,State of Minas Gerais,Brazil",
-	"Passos,State of Minas Gerais,Brazil",
-	"Patrocinio,State of Minas Gerais,Brazil",
-	"Pirapora,State of Minas Gerais,Brazil",
-	"Pocos de Caldas,State of Minas Gerais,Brazil",
-	"Sao Joao del Rei,State of Minas Gerais,Brazil",
-	"Sete Lagoas,State of Minas Gerais,Brazil",
-	"Teofilo Otoni,State of Minas Gerais,Brazil",
-	"Tres Coracoes,State of Minas Gerais,Brazil",
-	"Uberaba,State of Minas Gerais,Brazil",
-	"Uberlandia,State of Minas Gerais,Brazil",
-	"Unai,State of Minas Gerais,Brazil",
-	"Vicosa,State of Minas Gerais,Brazil",
-	"Campo Grande,State of Mato Grosso do Sul,Brazil",
-	"Corumba,State of Mato Grosso do Sul,Brazil",
-	"Dourados,State of Mato Grosso do Sul,Brazil",
-	"Ponta Pora,State of Mato Grosso do Sul,Brazil",
-	"Tres Lagoas,State of Mato Grosso do Sul,Brazil",
-	"Alta Floresta,State of Mato Grosso,Brazil",
-	"Cuiaba,State of Mato Grosso,Brazil",
-	"Presidente Epitacio,State of Sao Paulo,Brazil",
-	"Rondonopolis,State of Mato Grosso,Brazil",
-	"Sinop,State of Mato Grosso,Brazil",
-	"Tangara da Serra,State of Mato Grosso,Brazil",
-	"Altamira,State of Para,Brazil",
-	"Belem,State of Para,Brazil",
-	"Castanhal,State of Para,Brazil",
-	"Itaituba,State of Para,Brazil",
-	"Maraba,State of Para,Brazil",
-	"Paragominas,State of Para,Brazil",
-	"Redencao,State of Para,Brazil",
-	"Santarem,State of Para,Brazil",
-	"Tucurui,State of Para,Brazil",
-	"Campina Grande,State of Paraiba,Brazil",
-	"Joao Pessoa,State of Paraiba,Brazil",
-	"Paulista,State of Pernambuco,Brazil",
-	"Recife,State of Pernambuco,Brazil",
-	"Teresina,State of Piaui,Brazil",
-	"Apucarana,State of Parana,Brazil",
-	"Cafelandia,State of Parana,Brazil",
-	"Cascavel,State of Parana,Brazil",
-	"Cornelio Procopio,State of Parana,Brazil",
-	"Curitiba,State of Parana,Brazil",
-	"Guarapuava,State of Parana,Brazil",
-	"Ibaiti,State of Parana,Brazil",
-	"Londrina,State of Parana,Brazil",
-	"Palmas,State of Parana,Brazil",
-	"Paranagua,State of Parana,Brazil",
-	"Ponta Grossa,State of Parana,Brazil",
-	"Santo Antonio da Platina,State of Parana,Brazil",
-	"Sao Mateus do Sul,State of Parana,Brazil",
-	"Umuarama,State of Parana,Brazil",
-	"Barra Mansa,State of Rio de Janeiro,Brazil",
-	"Cabo Frio,State of Rio de Janeiro,Brazil",
-	"Campos dos Goytacazes,State of Rio de Janeiro,Brazil",
-	"Itaguai,State of Rio de Janeiro,Brazil",
-	"Itaperuna,State of Rio de Janeiro,Brazil",
-	"Macae,State of Rio de Janeiro,Brazil",
-	"Niteroi,State of Rio de Janeiro,Brazil",
-	"Nova Friburgo,State of Rio de Janeiro,Brazil",
-	"Nova Iguacu,State of Rio de Janeiro,Brazil",
-	"Petropolis,State of Rio de Janeiro,Brazil",
-	"Porto Real,State of Rio de Janeiro,Brazil",
-	"Rio de Janeiro,State of Rio de Janeiro,Brazil",
-	"Sao Jose do Vale do Rio Preto,State of Rio de Janeiro,Brazil",
-	"Seropedica,State of Rio de Janeiro,Brazil",
-	"Teresopolis,State of Rio de Janeiro,Brazil",
-	"Valenca,State of Rio de Janeiro,Brazil",
-	"Vassouras,State of Rio de Janeiro,Brazil",
-	"Mossoro,State of Rio Grande do Norte,Brazil",
-	"Natal,State of Rio Grande do Norte,Brazil",
-	"Cacoal,State of Rondonia,Brazil",
-	"Ji-Parana,State of Rondonia,Brazil",
-	"Porto Velho,State of Rondonia,Brazil",
-	"Bage,State of Rio Grande do Sul,Brazil",
-	"Cachoeira do Sul,State of Rio Grande do Sul,Brazil",
-	"Camaqua,State of Rio Grande do Sul,Brazil",
-	"Canoas,State of Rio Grande do Sul,Brazil",
-	"Capao da Canoa,State of Rio Grande do Sul,Brazil",
-	"Caxias do Sul,State of Rio Grande do Sul,Brazil",
-	"Erechim,State of Rio Grande do Sul,Brazil",
-	"Flores da Cunha,State of Rio Grande do Sul,Brazil",
-	"Guapore,State of Rio Grande do Sul,Brazil",
-	"Ibiruba,State of Rio Grande do Sul,Brazil",
-	"Ijui,State of Rio Grande do Sul,Brazil",
-	"Jaguarao,State of Rio Grande do Sul,Brazil",
-	"Lajeado,State of Rio Grande do Sul,Brazil",
-	"Novo Hamburgo,State of Rio Grande do Sul,Brazil",
-	"Palmeira das Missoes,State of Rio Grande do Sul,Brazil",
-	"Parobe,State of Rio Grande do Sul,Brazil",
-	"Passo Fundo,State of Rio Grande do Sul,Brazil",
-	"Pelotas,State of Rio Grande do Sul,Brazil",
-	"Porto Alegre,State of Rio Grande do Sul,Brazil",
-	"Rio Grande,State of Rio Grande do Sul,Brazil",
-	"Santa Cruz do Sul,State of Rio Grande do Sul,Brazil",
-	"Santa Maria,State of Rio Grande do Sul,Brazil",
-	"Santa Rosa,State of Rio Grande do Sul,Brazil",
-	"Santana do Livramento,State of Rio Grande do Sul,Brazil",
-	"Sao Leopoldo,State of Rio Grande do Sul,Brazil",
-	"Tapes,State of Rio Grande do Sul,Brazil",
-	"Taquari,State of Rio Grande do Sul,Brazil",
-	"Torres,State of Rio Grande do Sul,Brazil",
-	"Uruguaiana,State of Rio Grande do Sul,Brazil",
-	"Vacaria,State of Rio Grande do Sul,Brazil",
-	"Blumenau,State of Santa Catarina,Brazil",
-	"Braco do Norte,State of Santa Catarina,Brazil",
-	"Cacador,State of Santa Catarina,Brazil",
-	"Canoinhas,State of Santa Catarina,Brazil",
-	"Chapeco,State of Santa Catarina,Brazil",
-	"Concordia,State of Santa Catarina,Brazil",
-	"Criciuma,State of Santa Catarina,Brazil",
-	"Curitibanos,State of Santa Catarina,Brazil",
-	"Florianopolis,State of Santa Catarina,Brazil",
-	"Imbituba,State of Santa Catarina,Brazil",
-	"Itajai,State of Santa Catarina,Brazil",
-	"Joacaba,State of Santa Catarina,Brazil",
-	"Joinville,State of Santa Catarina,Brazil",
-	"Navegantes,State of Santa Catarina,Brazil",
-	"Rio do Sul,State of Santa Catarina,Brazil",
-	"Rio Negrinho,State of Santa Catarina,Brazil",
-	"Aracaju,State of Sergipe,Brazil",
-	"Adamantina,State of Sao Paulo,Brazil",
-	"Americana,State of Sao Paulo,Brazil",
-	"Aracatuba,State of Sao Paulo,Brazil",
-	"Araraquara,State of Sao Paulo,Brazil",
-	"Assis,State of Sao Paulo,Brazil",
-	"Avare,State of Sao Paulo,Brazil",
-	"Bariri,State of Sao Paulo,Brazil",
-	"Barretos,State of Sao Paulo,Brazil",
-	"Barueri,State of Sao Paulo,Brazil",
-	"Bauru,State of Sao Paulo,Brazil",
-	"Botucatu,State of Sao Paulo,Brazil",
-	"Braganca Paulista,State of Sao Paulo,Brazil",
-	"Cachoeira Paulista,State of Sao Paulo,Brazil",
-	"Campinas,State of Sao Paulo,Brazil",
-	"Catanduva,State of Sao Paulo,Brazil",
-	"Cotia,State of Sao Paulo,Brazil",
-	"Cubatao,State of Sao Paulo,Brazil",
-	"Dracena,State of Sao Paulo,Brazil",
-	"Fernandopolis,State of Sao Paulo,Brazil",
-	"Franca,State of Sao Paulo,Brazil",
-	"Guarulhos,State of Sao Paulo,Brazil",
-	"Hortolandia,State of Sao Paulo,Brazil",
-	"Indaiatuba,State of Sao Paulo,Brazil",
-	"Ipero,State of Sao Paulo,Brazil",
-	"Itapetininga,State of Sao Paulo,Brazil",
-	"Itapeva,State of Sao Paulo,Brazil",
-	"Itarare,State of Sao Paulo,Brazil",
-	"Jundiai,State of Sao Paulo,Brazil",
-	"Laranjal Paulista,State of Sao Paulo,Brazil",
-	"Leme,State of Sao Paulo,Brazil",
-	"Limeira,State of Sao Paulo,Brazil",
-	"Lins,State of Sao Paulo,Brazil",
-	"Lorena,State of Sao Paulo,Brazil",
-	"Marilia,State of Sao Paulo,Brazil",
-	"Mococa,State of Sao Paulo,Brazil",
-	"Mogi Guacu,State of Sao Paulo,Brazil",
-	"Moji-Mirim,State of Sao Paulo,Brazil",
-	"Nova Odessa,State of Sao Paulo,Brazil",
-	"Osasco,State of Sao Paulo,Brazil",
-	"Ourinhos,State of Sao Paulo,Brazil",
-	"Paulinia,State of Sao Paulo,Brazil",
-	"Pindamonhangaba,State of Sao Paulo,Brazil",
-	"Piracicaba,State of Sao Paulo,Brazil",
-	"Porto Ferreira,State of Sao Paulo,Brazil",
-	"Presidente Prudente,State of Sao Paulo,Brazil",
-	"Registro,State of Sao Paulo,Brazil",
-	"Ribeirao Pires,State of Sao Paulo,Brazil",
-	"Ribeirao Preto,State of Sao Paulo,Brazil",
-	"Rio Claro,State of Sao Paulo,Brazil",
-	"Santo Andre,State of Sao Paulo,Brazil",
-	"Santos,State of Sao Paulo,Brazil",
-	"Sao Bernardo do Campo,State of Sao Paulo,Brazil",
-	"Sao Caetano do Sul,State of Sao Paulo,Brazil",
-	"Sao Carlos,State of Sao Paulo,Brazil",
-	"Sao Joao da Boa Vista,State of Sao Paulo,Brazil",
-	"Sao Jose do Rio Preto,State of Sao Paulo,Brazil",
-	"Sao Jose dos Campos,State of Sao Paulo,Brazil",
-	"Sao Paulo,State of Sao Paulo,Brazil",
-	"Sao Sebastiao,State of Sao Paulo,Brazil",
-	"Sao Vicente,State of Sao Paulo,Brazil",
-	"Sorocaba,State of Sao Paulo,Brazil",
-	"Suzano,State of Sao Paulo,Brazil",
-	"Taubate,State of Sao Paulo,Brazil",
-	"Tupa,State of Sao Paulo,Brazil",
-	"Araguaina,State of Tocantins,Brazil",
-	"Abaetetuba,State of Para,Brazil",
-	"Abreu e Lima,State of Pernambuco,Brazil",
-	"Afonso Claudio,State of Espirito Santo,Brazil",
-	"Aguai,State of Sao Paulo,Brazil",
-	"Agudos,State of Sao Paulo,Brazil",
-	"Alagoa Grande,State of Paraiba,Brazil",
-	"Alegre,State of Espirito Santo,Brazil",
-	"Alegrete,State of Rio Grande do Sul,Brazil",
-	"Alfredo Chaves,State of Espirito Santo,Brazil",
-	"Almirante Tamandare,State of Parana,Brazil",
-	"Alvorada,State of Rio Grande do Sul,Brazil",
-	"Alem Paraiba,State of Minas Gerais,Brazil",
-	"Amparo,State of Sao Paulo,Brazil",
-	"Ampere,State of Parana,Brazil",
-	"Americo Brasiliense,State of Sao Paulo,Brazil",
-	"Ananindeua,State of Para,Brazil",
-	"Anchieta,State of Espirito Santo,Brazil",
-	"Andira,State of Parana,Brazil",
-	"Andradina,State of Sao Paulo,Brazil",
-	"Angra dos Reis,State of Rio de Janeiro,Brazil",
-	"Antonina,State of Parana,Brazil",
-	"Anapolis,State of Goias,Brazil",
-	"Aparecida,State of Sao Paulo,Brazil",
-	"Aparecida de Goiania,State of Goias,Brazil",
-	"Apiai,State of Sao Paulo,Brazil",
-	"Aquidauana,State of Mato Grosso do Sul,Brazil",
-	"Aquiraz,State of Ceara,Brazil",
-	"Aracati,State of Ceara,Brazil",
-	"Aracruz,State of Espirito Santo,Brazil",
-	"Araguari,State of Minas Gerais,Brazil",
-	"Arapiraca,State of Alagoas,Brazil",
-	"Arapongas,State of Parana,Brazil",
-	"Arapoti,State of Parana,Brazil",
-	"Araquari,State of Santa Catarina,Brazil",
-	"Ararangua,State of Santa Catarina,Brazil",
-	"Araras,State of Sao Paulo,Brazil",
-	"Araripina,State of Pernambuco,Brazil",
-	"Araruama,State of Rio de Janeiro,Brazil",
-	"Araucaria,State of Parana,Brazil",
-	"Aracoiaba,State of Pernambuco,Brazil",
-	"Aracoiaba da Serra,State of Sao Paulo,Brazil",
-	"Arcoverde,State of Pernambuco,Brazil",
-	"Areal,State of Rio de Janeiro,Brazil",
-	"Ariquemes,State of Rondonia,Brazil",
-	"Arraial do Cabo,State of Rio de Janeiro,Brazil",
-	"Arroio do Meio,State of Rio Grande do Sul,Brazil",
-	"Arroio dos Ratos,State of Rio Grande do Sul,Brazil",
-	"Artur Nogueira,State of Sao Paulo,Brazil",
-	"Aruja,State of Sao Paulo,Brazil",
-	"Assai,State of Parana,Brazil",
-	"Assis Chateaubriand,State of Parana,Brazil",
-	"Astorga,State of Parana,Brazil",
-	"Atalaia,State of Alagoas,Brazil",
-	"Atibaia,State of Sao Paulo,Brazil",
-	"Acailandia,State of Maranhao,Brazil",
-	"Bacabal,State of Maranhao,Brazil",
-	"Balneario Camboriu,State of Santa Catarina,Brazil",
-	"Bandeirantes,State of Parana,Brazil",
-	"Barbalha,State of Ceara,Brazil",
-	"Barcarena,State of Para,Brazil",
-	"Barra Bonita,State of Sao Paulo,Brazil",
-	"Barra Velha,State of Santa Catarina,Brazil",
-	"Barra de Sao Francisco,State of Espirito Santo,Brazil",
-	"Barra do Corda,State of Maranhao,Brazil",
-	"Barra do Garcas,State of Mato Grosso,Brazil",
-	"Barra do Pirai,State of Rio de Janeiro,Brazil",
-	"Barrinha,State of Sao Paulo,Brazil",
-	"Batatais,State of Sao Paulo,Brazil",
-	"Bayeux,State of Paraiba,Brazil",
-	"Bebedouro,State of Sao Paulo,Brazil",
-	"Bela Vista do Paraiso,State of Parana,Brazil",
-	"Belford Roxo,State of Rio de Janeiro,Brazil",
-	"Bento Goncalves,State of Rio Grande do Sul,Brazil",
-	"Bertioga,State of Sao Paulo,Brazil",
-	"Betim,State of Minas Gerais,Brazil",
-	"Bezerros,State of Pernambuco,Brazil",
-	"Biguacu,State of Santa Catarina,Brazil",
-	"Birigui,State of Sao Paulo,Brazil",
-	"Biritiba-Mirim,State of Sao Paulo,Brazil",
-	"Boa Vista,State of Roraima,Brazil",
-	"Boituva,State of Sao Paulo,Brazil",
-	"Bom Despacho,State of Minas Gerais,Brazil",
-	"Bom Jardim,State of Rio de Janeiro,Brazil",
-	"Bom Jesus do Itabapoana,State of Rio de Janeiro,Brazil",
-	"Bom Jesus dos Perdoes,State of Sao Paulo,Brazil",
-	"Bombinhas,State of Santa Catarina,Brazil",
-	"Bonito,State of Mato Grosso do Sul,Brazil",
-	"Borborema,State of Sao Paulo,Brazil",
-	"Borda da Mata,State of Minas Gerais,Brazil",
-	"Braganca,State of Para,Brazil",
-	"Breves,State of Para,Brazil",
-	"Brotas,State of Sao Paulo,Brazil",
-	"Brumado,State of Bahia,Brazil",
-	"Brusque,State of Santa Catarina,Brazil",
-	"Bueno Brandao,State of Minas Gerais,Brazil",
-	"Butia,State of Rio Grande do Sul,Brazil",
-	"Cabo de Santo Agostinho,State of Pernambuco,Brazil",
-	"Cabreuva,State of Sao Paulo,Brazil",
-	"Cachoeiras de Macacu,State of Rio de Janeiro,Brazil",
-	"Cachoeirinha,State of Rio Grande do Sul,Brazil",
-	"Caete,State of Minas Gerais,Brazil",
-	"Caieiras,State of Sao Paulo,Brazil",
-	"Cajamar,State of Sao Paulo,Brazil",
-	"Cajati,State of Sao Paulo,Brazil",
-	"Cajazeiras,State of Paraiba,Brazil",
-	"Cajuru,State of Sao Paulo,Brazil",
-	"Caldas Novas,State of Goias,Brazil",
-	"Camanducaia,State of Minas Gerais,Brazil",
-	"Camaragibe,State of Pernambuco,Brazil",
-	"Camacari,State of Bahia,Brazil",
-	"Cambara,State of Parana,Brazil",
-	"Camboriu,State of Santa Catarina,Brazil",
-	"Cambuci,State of Rio de Janeiro,Brazil",
-	"Cambui,State of Minas Gerais,Brazil",
-	"Cambe,State of Parana,Brazil",
-	"Cameta,State of Para,Brazil",
-	"Campina Grande do Sul,State of Parana,Brazil",
-	"Campina Verde,State of Minas Gerais,Brazil",
-	"Campo Belo,State of Minas Gerais,Brazil",
-	"Campo Bom,State of Rio Grande do Sul,Brazil",
-	"Campo Formoso,State of Bahia,Brazil",
-	"Campo Largo,State of Parana,Brazil",
-	"Campo Limpo Paulista,State of Sao Paulo,Brazil",
-	"Campo Mourao,State of Parana,Brazil",
-	"Campos Altos,State of Minas Gerais,Brazil",
-	"Campos Novos,State of Santa Catarina,Brazil",
-	"Campos do Jordao,State of Sao Paulo,Brazil",
-	"Cananeia,State of Sao Paulo,Brazil",
-	"Candeias,State of Bahia,Brazil",
-	"Candelaria,State of Rio Grande do Sul,Brazil",
-	"Canela,State of Rio Grande do Sul,Brazil",
-	"Cangucu,State of Rio Grande do Sul,Brazil",
-	"Cantagalo,State of Rio de Janeiro,Brazil",
-	"Capanema,State of Parana,Brazil",
-	"Capinopolis,State of Minas Gerais,Brazil",
-	"Capivari,State of Sao Paulo,Brazil",
-	"Capao Bonito,State of Sao Paulo,Brazil",
-	"Caraguatatuba,State of Sao Paulo,Brazil",
-	"Carambei,State of Parana,Brazil",
-	"Carangola,State of Minas Gerais,Brazil",
-	"Carapicuiba,State of Sao Paulo,Brazil",
-	"Caratinga,State of Minas Gerais,Brazil",
-	"Carazinho,State of Rio Grande do Sul,Brazil",
-	"Cariacica,State of Espirito Santo,Brazil",
-	"Carlos Barbosa,State of Rio Grande do Sul,Brazil",
-	"Carmo,State of Rio de Janeiro,Brazil",
-	"Carmo do Paranaiba,State of Minas Gerais,Brazil",
-	"Carpina,State of Pernambuco,Brazil",
-	"Caruaru,State of Pernambuco,Brazil",
-	"Casa Branca,State of Sao Paulo,Brazil",
-	"Casimiro de Abreu,State of Rio de Janeiro,Brazil",
-	"Castro,State of Parana,Brazil",
-	"Catu,State of Bahia,Brazil",
-	"Caucaia,State of Ceara,Brazil",
-	"Caxias,State of Maranhao,Brazil",
-	"Cacapava,State of Sao Paulo,Brazil",
-	"Cacapava do Sul,State of Rio Grande do Sul,Brazil",
-	"Ceara-Mirim,State of Rio Grande do Norte,Brazil",
-	"Cerqueira Cesar,State of Sao Paulo,Brazil",
-	"Cerquilho,State of Sao Paulo,Brazil",
-	"Chapadinha,State of Maranhao,Brazil",
-	"Charqueada,State of Sao Paulo,Brazil",
-	"Charqueadas,State of Rio Grande do Sul,Brazil",
-	"Chopinzinho,State of Parana,Brazil",
-	"Cianorte,State of Parana,Brazil",
-	"Clevelandia,State of Parana,Brazil",
-	"Coari,State of Amazonas,Brazil",
-	"Cocal do Sul,State of Santa Catarina,Brazil",
-	"Codo,State of Maranhao,Brazil",
-	"Colombo,State of Parana,Brazil",
-	"Colorado,State of Parana,Brazil",
-	"Conceicao da Barra,State of Espirito Santo,Brazil",
-	"Conceicao das Alagoas,State of Minas Gerais,Brazil",
-	"Conchal,State of Sao Paulo,Brazil",
-	"Conchas,State of Sao Paulo,Brazil",
-	"Congonhas,State of Minas Gerais,Brazil",
-	"Conselheiro Lafaiete,State of Minas Gerais,Brazil",
-	"Contagem,State of Minas Gerais,Brazil",
-	"Corbelia,State of Parana,Brazil",
-	"Cordeiro,State of Rio de Janeiro,Brazil",
-	"Cordeiropolis,State of Sao Paulo,Brazil",
-	"Coromandel,State of Minas Gerais,Brazil",
-	"Coronel Fabriciano,State of Minas Gerais,Brazil",
-	"Coronel Vivida,State of Parana,Brazil",
-	"Cosmopolis,State of Sao Paulo,Brazil",
-	"Crateus,State of Ceara,Brazil",
-	"Crato,State of Ceara,Brazil",
-	"Cravinhos,State of Sao Paulo,Brazil",
-	"Cristalina,State of Goias,Brazil",
-	"Cruz Alta,State of Rio Grande do Sul,Brazil",
-	"Cruzeiro,State of Sao Paulo,Brazil",
-	"Cruzeiro do Oeste,State of Parana,Brazil",
-	"Cruzeiro do Sul,State of Acre,Brazil",
-	"Cunha,State of Sao Paulo,Brazil",
-	"Curvelo,State of Minas Gerais,Brazil",
-	"Candido Mota,State of Sao Paulo,Brazil",
-	"Descalvado,State of Sao Paulo,Brazil",
-	"Diadema,State of Sao Paulo,Brazil",
-	"Diamantina,State of Minas Gerais,Brazil",
-	"Dias d'Avila,State of Bahia,Brazil",
-	"Dois Corregos,State of Sao Paulo,Brazil",
-	"Dois Vizinhos,State of Parana,Brazil",
-	"Dom Pedrito,State of Rio Grande do Sul,Brazil",
-	"Duartina,State of Sao Paulo,Brazil",
-	"Duque de Caxias,State of Rio de Janeiro,Brazil",
-	"Ecoporanga,State of Espirito Santo,Brazil",
-	"Elias Fausto,State of Sao Paulo,Brazil",
-	"Embu,State of Sao Paulo,Brazil",
-	"Embu-Guacu,State of Sao Paulo,Brazil",
-	"Escada,State of Pernambuco,Brazil",
-	"Esmeraldas,State of Minas Gerais,Brazil",
-	"Espirito Santo do Pinhal,State of Sao Paulo,Brazil",
-	"Esteio,State of Rio Grande do Sul,Brazil",
-	"Estrela,State of Rio Grande do Sul,Brazil",
-	"Estancia,State of Sergipe,Brazil",
-	"Estancia Velha,State of Rio Grande do Sul,Brazil",
-	"Eusebio,State of Ceara,Brazil",
-	"Extrema,State of Minas Gerais,Brazil",
-	"Extremoz,State of Rio Grande do Norte,Brazil",
-	"Farroupilha,State of Rio Grande do Sul,Brazil",
-	"Faxinal,State of Parana,Brazil",
-	"Ferraz de Vasconcelos,State of Sao Paulo,Brazil",
-	"Formiga,State of Minas Gerais,Brazil",
-	"Formosa,State of Goias,Brazil",
-	"Forquilhinha,State of Santa Catarina,Brazil",
-	"Foz do Iguacu,State of Parana,Brazil",
-	"Francisco Beltrao,State of Parana,Brazil",
-	"Francisco Morato,State of Sao Paulo,Brazil",
-	"Franco da Rocha,State of Sao Paulo,Brazil",
-	"Frederico Westphalen,State of Rio Grande do Sul,Brazil",
-	"Frutal,State of Minas Gerais,Brazil",
-	"Fundao,State of Espirito Santo,Brazil",
-	"Garanhuns,State of Pernambuco,Brazil",
-	"Garibaldi,State of Rio Grande do Sul,Brazil",
-	"Garopaba,State of Santa Catarina,Brazil",
-	"Garca,State of Sao Paulo,Brazil",
-	"Gaspar,State of Santa Catarina,Brazil",
-	"Girua,State of Rio Grande do Sul,Brazil",
-	"Gloria do Goita,State of Pernambuco,Brazil",
-	"Goiana,State of Pernambuco,Brazil",
-	"Goianira,State of Goias,Brazil",
-	"Goianapolis,State of Goias,Brazil",
-	"Goianesia,State of Goias,Brazil",
-	"Goioere,State of Parana,Brazil",
-	"Gramado,State of Rio Grande do Sul,Brazil",
-	"Gravatai,State of Rio Grande do Sul,Brazil",
-	"Gravata,State of Pernambuco,Brazil",
-	"Guaiuba,State of Ceara,Brazil",
-	"Guapimirim,State of Rio de Janeiro,Brazil",
-	"Guapo,State of Goias,Brazil",
-	"Guaramirim,State of Santa Catarina,Brazil",
-	"Guarapari,State of Espirito Santo,Brazil",
-	"Guararapes,State of Sao Paulo,Brazil",
-	"Guararema,State of Sao Paulo,Brazil",
-	"Guaratingueta,State of Sao Paulo,Brazil",
-	"Guaratuba,State of Parana,Brazil",
-	"Guariba,State of Sao Paulo,Brazil",
-	"Guaruja,State of Sao Paulo,Brazil",
-	"Guara,State of Sao Paulo,Brazil",
-	"Guaxupe,State of Minas Gerais,Brazil",
-	"Guacui,State of Espirito Santo,Brazil",
-	"Guaiba,State of Rio Grande do Sul,Brazil",
-	"Guaira,State of Sao Paulo,Brazil",
-	"Guaira,State of Parana,Brazil",
-	"Gurupi,State of Tocantins,Brazil",
-	"Herval d'Oeste,State of Santa Catarina,Brazil",
-	"Holambra,State of Sao Paulo,Brazil",
-	"Ibatiba,State of Espirito Santo,Brazil",
-	"Ibate,State of Sao Paulo,Brazil",
-	"Ibipora,State of Parana,Brazil",
-	"Ibirite,State of Minas Gerais,Brazil",
-	"Ibitinga,State of Sao Paulo,Brazil",
-	"Ibiuna,State of Sao Paulo,Brazil",
-	"Ico,State of Ceara,Brazil",
-	"Igarapava,State of Sao Paulo,Brazil",
-	"Igarape,State of Minas Gerais,Brazil",
-	"Igarassu,State of Pernambuco,Brazil",
-	"Igaracu do Tiete,State of Sao Paulo,Brazil",
-	"Igrejinha,State of Rio Grande do Sul,Brazil",
-	"Iguaba Grande,State of Rio de Janeiro,Brazil",
-	"Iguape,State of Sao Paulo,Brazil",
-	"Iguatu,State of Ceara,Brazil",
-	"Ilhabela,State of Sao Paulo,Brazil",
-	"Ilheus,State of Bahia,Brazil",
-	"Imbituva,State of Parana,Brazil",
-	"Indaial,State of Santa Catarina,Brazil",
-	"Ipameri,State of Goias,Brazil",
-	"Ipira,State of Bahia,Brazil",
-	"Ipojuca,State of Pernambuco,Brazil",
-	"Ipora,State of Parana,Brazil",
-	"Iracemapolis,State of Sao Paulo,Brazil",
-	"Irati,State of Parana,Brazil",
-	"Itabaiana,State of Sergipe,Brazil",
-	"Itaberaba,State of Bahia,Brazil",
-	"Itabira,State of Minas Gerais,Brazil",
-	"Itabirito,State of Minas Gerais,Brazil",
-	"Itaborai,State of Rio de Janeiro,Brazil",
-	"Itaitinga,State of Ceara,Brazil",
-	"Itamaraca,State of Pernambuco,Brazil",
-	"Itanhaem,State of Sao Paulo,Brazil",
-	"Itaocara,State of Rio de Janeiro,Brazil",
-	"Itaparica,State of Bahia,Brazil",
-	"Itapecerica da Serra,State of Sao Paulo,Brazil",
-	"Itapema,State of Santa Catarina,Brazil",
-	"Itapemirim,State of Espirito Santo,Brazil",
-	"Itaperucu,State of Parana,Brazil",
-	"Itapevi,State of Sao Paulo,Brazil",
-	"Itapipoca,State of Ceara,Brazil",
-	"Itapira,State of Sao Paulo,Brazil",
-	"Itapissuma,State of Pernambuco,Brazil",
-	"Itapora,State of Mato Grosso do Sul,Brazil",
-	"Itaquaquecetuba,State of Sao Paulo,Brazil",
-	"Itaqui,State of Rio Grande do Sul,Brazil",
-	"Itatiaia,State of Rio de Janeiro,Brazil",
-	"Itatiba,State of Sao Paulo,Brazil",
-	"Itatinga,State of Sao Paulo,Brazil",
-	"Itai,State of Sao Paulo,Brazil",
-	"Itauna,State of Minas Gerais,Brazil",
-	"Itirapina,State of Sao Paulo,Brazil",
-	"Itu,State of Sao Paulo,Brazil",
-	"Ituiutaba,State of Minas Gerais,Brazil",
-	"Itupeva,State of Sao Paulo,Brazil",
-	"Iturama,State of Minas Gerais,Brazil",
-	"Ituverava,State of Sao Paulo,Brazil",
-	"Itapolis,State of Sao Paulo,Brazil",
-	"Ivaipora,State of Parana,Brazil",
-	"Ivinhema,State of Mato Grosso do Sul,Brazil",
-	"Ivoti,State of Rio Grande do Sul,Brazil",
-	"Icara,State of Santa Catarina,Brazil",
-	"Iuna,State of Espirito Santo,Brazil",
-	"Jaboticabal,State of Sao Paulo,Brazil",
-	"Jacarezinho,State of Parana,Brazil",
-	"Jacarei,State of Sao Paulo,Brazil",
-	"Jacobina,State of Bahia,Brazil",
-	"Jacutinga,State of Minas Gerais,Brazil",
-	"Jaguariaiva,State of Parana,Brazil",
-	"Jaguariuna,State of Sao Paulo,Brazil",
-	"Jaguaruna,State of Santa Catarina,Brazil",
-	"Jaguare,State of Espirito Santo,Brazil",
-	"Jales,State of Sao Paulo,Brazil",
-	"Janauba,State of Minas Gerais,Brazil",
-	"Jandaia do Sul,State of Parana,Brazil",
-	"Jandira,State of Sao Paulo,Brazil",
-	"Januaria,State of Minas Gerais,Brazil",
-	"Japeri,State of Rio de Janeiro,Brazil",
-	"Jaragua do Sul,State of Santa Catarina,Brazil",
-	"Jardim,State of Mato Grosso do Sul,Brazil",
-	"Jardinopolis,State of Sao Paulo,Brazil",
-	"Jarinu,State of Sao Paulo,Brazil",
-	"Jaru,State of Rondonia,Brazil",
-	"Jau,State of Sao Paulo,Brazil",
-	"Joanopolis,State of Sao Paulo,Brazil",
-	"Joaquim Gomes,State of Alagoas,Brazil",
-	"Jose Bonifacio,State of Sao Paulo,Brazil",
-	"Joao Monlevade,State of Minas Gerais,Brazil",
-	"Joao Neiva,State of Espirito Santo,Brazil",
-	"Juquitiba,State of Sao Paulo,Brazil",
-	"Ladario,State of Mato Grosso do Sul,Brazil",
-	"Lagarto,State of Sergipe,Brazil",
-	"Lages,State of Santa Catarina,Brazil",
-	"Lagoa Formosa,State of Minas Gerais,Brazil",
-	"Lagoa Santa,State of Minas Gerais,Brazil",
-	"Lagoa Vermelha,State of Rio Grande do Sul,Brazil",
-	"Lagoa da Prata,State of Minas Gerais,Brazil",
-	"Laguna,State of Santa Catarina,Brazil",
-	"Lapa,State of Parana,Brazil",
-	"Laranjeiras do Sul,State of Parana,Brazil",
-	"Lauro de Freitas,State of Bahia,Brazil",
-	"Lencois Paulista,State of Sao Paulo,Brazil",
-	"Leopoldina,State of Minas Gerais,Brazil",
-	"Loanda,State of Parana,Brazil",
-	"Louveira,State of Sao Paulo,Brazil",
-	"Luz,State of Minas Gerais,Brazil",
-	"Luziania,State of Goias,Brazil",
-	"Macaiba,State of Rio Grande do Norte,Brazil",
-	"Madre de Deus,State of Bahia,Brazil",
-	"Mafra,State of Santa Catarina,Brazil",
-	"Mage,State of Rio de Janeiro,Brazil",
-	"Mairinque,State of Sao Paulo,Brazil",
-	"Mairipora,State of Sao Paulo,Brazil",
-	"Manacapuru,State of Amazonas,Brazil",
-	"Mandaguari,State of Parana,Brazil",
-	"Mandaguacu,State of Parana,Brazil",
-	"Mangaratiba,State of Rio de Janeiro,Brazil",
-	"Mangueirinha,State of Parana,Brazil",
-	"Maracanau,State of Ceara,Brazil",
-	"Maragogi,State of Alagoas,Brazil",
-	"Maranguape,State of Ceara,Brazil",
-	"Marataizes,State of Espirito Santo,Brazil",
-	"Marau,State of Rio Grande do Sul,Brazil",
-	"Marechal Candido Rondon,State of Parana,Brazil",
-	"Marialva,State of Parana,Brazil",
-	"Mariana,State of Minas Gerais,Brazil",
-	"Marica,State of Rio de Janeiro,Brazil",
-	"Maringa,State of Parana,Brazil",
-	"Martinopolis,State of Sao Paulo,Brazil",
-	"Mata de Sao Joao,State of Bahia,Brazil",
-	"Matelandia,State of Parana,Brazil",
-	"Mateus Leme,State of Minas Gerais,Brazil",
-	"Matinhos,State of Parana,Brazil",
-	"Matozinhos,State of Minas Gerais,Brazil",
-	"Matao,State of Sao Paulo,Brazil",
-	"Maua,State of Sao Paulo,Brazil",
-	"Medianeira,State of Parana,Brazil",
-	"Mendes,State of Rio de Janeiro,Brazil",
-	"Mesquita,State of Rio de Janeiro,Brazil",
-	"Messias,State of Alagoas,Brazil",
-	"Miguel Pereira,State of Rio de Janeiro,Brazil",
-	"Mimoso do Sul,State of Espirito Santo,Brazil",
-	"Mineiros,State of Goias,Brazil",
-	"Miracema,State of Rio de Janeiro,Brazil",
-	"Mirandopolis,State of Sao Paulo,Brazil",
-	"Mirassol,State of Sao Paulo,Brazil",
-	"Mogi das Cruzes,State of Sao Paulo,Brazil",
-	"Mongagua,State of Sao Paulo,Brazil",
-	"Montanha,State of Espirito Santo,Brazil",
-	"Monte Alegre,State of Para,Brazil",
-	"Monte Alegre de Minas,State of Minas Gerais,Brazil",
-	"Monte Alegre do Sul,State of Sao Paulo,Brazil",
-	"Monte Alto,State of Sao Paulo,Brazil",
-	"Monte Aprazivel,State of Sao Paulo,Brazil",
-	"Monte Mor,State of Sao Paulo,Brazil",
-	"Monte Santo de Minas,State of Minas Gerais,Brazil",
-	"Monte Siao,State of Minas Gerais,Brazil",
-	"Montenegro,State of Rio Grande do Sul,Brazil",
-	"Morada Nova,State of Ceara,Brazil",
-	"Moreno,State of Pernambuco,Brazil",
-	"Morrinhos,State of Goias,Brazil",
-	"Morro Agudo,State of Sao Paulo,Brazil",
-	"Morro da Fumaca,State of Santa Catarina,Brazil",
-	"Muniz Freire,State of Espirito Santo,Brazil",
-	"Muriae,State of Minas Gerais,Brazil",
-	"Murici,State of Alagoas,Brazil",
-	"Natividade,State of Rio de Janeiro,Brazil",
-	"Navirai,State of Mato Grosso do Sul,Brazil",
-	"Neropolis,State of Goias,Brazil",
-	"Nilopolis,State of Rio de Janeiro,Brazil",
-	"Nossa Senhora do Socorro,State of Sergipe,Brazil",
-	"Nova Andradina,State of Mato Grosso do Sul,Brazil",
-	"Nova Hartz,State of Rio Grande do Sul,Brazil",
-	"Nova Lima,State of Minas Gerais,Brazil",
-	"Nova Londrina,State of Parana,Brazil",
-	"Nova Serrana,State of Minas Gerais,Brazil",
-	"Nova Venecia,State of Espirito Santo,Brazil",
-	"Novo Horizonte,State of Sao Paulo,Brazil",
-	"Olinda,State of Pernambuco,Brazil",
-	"Oliveira,State of Minas Gerais,Brazil",
-	"Olimpia,State of Sao Paulo,Brazil",
-	"Orlandia,State of Sao Paulo,Brazil",
-	"Ortigueira,State of Parana,Brazil",
-	"Osvaldo Cruz,State of Sao Paulo,Brazil",
-	"Osorio,State of Rio Grande do Sul,Brazil",
-	"Ouro Branco,State of Minas Gerais,Brazil",
-	"Ouro Fino,State of Minas Gerais,Brazil",
-	"Ouro Preto do Oeste,State of Rondonia,Brazil",
-	"Pacatuba,State of Ceara,Brazil",
-	"Paicandu,State of Parana,Brazil",
-	"Palhoca,State of Santa Catarina,Brazil",
-	"Palmas,State of Tocantins,Brazil",
-	"Palmeira,State of Parana,Brazil",
-	"Palmeira dos Indios,State of Alagoas,Brazil",
-	"Palmital,State of Sao Paulo,Brazil",
-	"Palotina,State of Parana,Brazil",
-	"Panambi,State of Rio Grande do Sul,Brazil",
-	"Papagaios,State of Minas Gerais,Brazil",
-	"Paracambi,State of Rio de Janeiro,Brazil",
-	"Paracatu,State of Minas Gerais,Brazil",
-	"Paraguacu Paulista,State of Sao Paulo,Brazil",
-	"Paranavai,State of Parana,Brazil",
-	"Paranaiba,State of Mato Grosso do Sul,Brazil",
-	"Paraty,State of Rio de Janeiro,Brazil",
-	"Parauapebas,State of Para,Brazil",
-	"Paraiba do Sul,State of Rio de Janeiro,Brazil",
-	"Paraiso do Tocantins,State of Tocantins,Brazil",
-	"Parintins,State of Amazonas,Brazil",
-	"Parnamirim,State of Rio Grande do Norte,Brazil",
-	"Parnaiba,State of Piaui,Brazil",
-	"Para de Minas,State of Minas Gerais,Brazil",
-	"Pato Branco,State of Parana,Brazil",
-	"Patos,State of Paraiba,Brazil",
-	"Patos de Minas,State of Minas Gerais,Brazil",
-	"Paty do Alferes,State of Rio de Janeiro,Brazil",
-	"Paulo Afonso,State of Bahia,Brazil",
-	"Pederneiras,State of Sao Paulo,Brazil",
-	"Pedreira,State of Sao Paulo,Brazil",
-	"Pedro Leopoldo,State of Minas Gerais,Brazil",
-	"Penha,State of Santa Catarina,Brazil",
-	"Penapolis,State of Sao Paulo,Brazil",
-	"Pereira Barreto,State of Sao Paulo,Brazil",
-	"Peruibe,State of Sao Paulo,Brazil",
-	"Petrolina,State of Pernambuco,Brazil",
-	"Picos,State of Piaui,Brazil",
-	"Piedade,State of Sao Paulo,Brazil",
-	"Pilar,State of Alagoas,Brazil",
-	"Pilar do Sul,State of Sao Paulo,Brazil",
-	"Pimenta Bueno,State of Rondonia,Brazil",
-	"Pindorama,State of Sao Paulo,Brazil",
-	"Pinhais,State of Parana,Brazil",
-	"Pinheiral,State of Rio de Janeiro,Brazil",
-	"Pinheiro,State of Maranhao,Brazil",
-	"Pinhao,State of Parana,Brazil",
-	"Piracaia,State of Sao Paulo,Brazil",
-	"Piraju,State of Sao Paulo,Brazil",
-	"Pirajui,State of Sao Paulo,Brazil",
-	"Pirapora do Bom Jesus,State of Sao Paulo,Brazil",
-	"Piraquara,State of Parana,Brazil",
-	"Pirassununga,State of Sao Paulo,Brazil",
-	"Pirai,State of Rio de Janeiro,Brazil",
-	"Pirai do Sul,State of Parana,Brazil",
-	"Pirenopolis,State of Goias,Brazil",
-	"Pires do Rio,State of Goias,Brazil",
-	"Piripiri,State of Piaui,Brazil",
-	"Pitanga,State of Parana,Brazil",
-	"Pitangueiras,State of Sao Paulo,Brazil",
-	"Pitangui,State of Minas Gerais,Brazil",
-	"Piuma,State of Espirito Santo,Brazil",
-	"Pojuca,State of Bahia,Brazil",
-	"Pombal,State of Paraiba,Brazil",
-	"Pombos,State of Pernambuco,Brazil",
-	"Pomerode,State of Santa Catarina,Brazil",
-	"Pompeia,State of Sao Paulo,Brazil",
-	"Pontal,State of Sao Paulo,Brazil",
-	"Pontal do Parana,State of Parana,Brazil",
-	"Ponte Nova,State of Minas Gerais,Brazil",
-	"Pontes e Lacerda,State of Mato Grosso,Brazil",
-	"Porangatu,State of Goias,Brazil",
-	"Porciuncula,State of Rio de Janeiro,Brazil",
-	"Porecatu,State of Parana,Brazil",
-	"Porto Belo,State of Santa Catarina,Brazil",
-	"Porto Calvo,State of Alagoas,Brazil",
-	"Porto Feliz,State of Sao Paulo,Brazil",
-	"Porto Nacional,State of Tocantins,Brazil",
-	"Porto Seguro,State of Bahia,Brazil",
-	"Portao,State of Rio Grande do Sul,Brazil",
-	"Pouso Alegre,State of Minas Gerais,Brazil",
-	"Poa,State of Sao Paulo,Brazil",
-	"Praia Grande,State of Sao Paulo,Brazil",
-	"Prata,State of Minas Gerais,Brazil",
-	"Presidente Medici,State of Rondonia,Brazil",
-	"Presidente Olegario,State of Minas Gerais,Brazil",
-	"Presidente Venceslau,State of Sao Paulo,Brazil",
-	"Primavera do Leste,State of Mato Grosso,Brazil",
-	"Promissao,State of Sao Paulo,Brazil",
-	"Prudentopolis,State of Parana,Brazil",
-	"Quatis,State of Rio de Janeiro,Brazil",
-	"Quatro Barras,State of Parana,Brazil",
-	"Quata,State of Sao Paulo,Brazil",
-	"Quedas do Iguacu,State of Parana,Brazil",
-	"Queimadas,State of Bahia,Brazil",
-	"Queimadas,State of Paraiba,Brazil",
-	"Queimados,State of Rio de Janeiro,Brazil",
-	"Quirinopolis,State of Goias,Brazil",
-	"Quixada,State of Ceara,Brazil",
-	"Quixeramobim,State of Ceara,Brazil",
-	"Rancharia,State of Sao Paulo,Brazil",
-	"Raposos,State of Minas Gerais,Brazil",
-	"Realeza,State of Parana,Brazil",
-	"Regente Feijo,State of Sao Paulo,Brazil",
-	"Resende,State of Rio de Janeiro,Brazil",
-	"Reserva,State of Parana,Brazil",
-	"Ribeirao das Neves,State of Minas Gerais,Brazil",
-	"Ribeirao do Pinhal,State of Parana,Brazil",
-	"Rio Bonito,State of Rio de Janeiro,Brazil",
-	"Rio Branco do Sul,State of Parana,Brazil",
-	"Rio Claro,State of Rio de Janeiro,Brazil",
-	"Rio Grande da Serra,State of Sao Paulo,Brazil",
-	"Rio Largo,State of Alagoas,Brazil",
-	"Rio Negro,State of Parana,Brazil",
-	"Rio Pardo,State of Rio Grande do Sul,Brazil",
-	"Rio das Ostras,State of Rio de Janeiro,Brazil",
-	"Rio das Pedras,State of Sao Paulo,Brazil",
-	"Rolim de Moura,State of Rondonia,Brazil",
-	"Rolandia,State of Parana,Brazil",
-	"Rosario do Sul,State of Rio Grande do Sul,Brazil",
-	"Sabara,State of Minas Gerais,Brazil",
-	"Salto,State of Sao Paulo,Brazil",
-	"Salto de Pirapora,State of Sao Paulo,Brazil",
-	"Salto do Lontra,State of Parana,Brazil",
-	"Santa Adelia,State of Sao Paulo,Brazil",
-	"Santa Barbara d'Oeste,State of Sao Paulo,Brazil",
-	"Santa Cruz das Palmeiras,State of Sao Paulo,Brazil",
-	"Santa Cruz do Capibaribe,State of Pernambuco,Brazil",
-	"Santa Cruz do Rio Pardo,State of Sao Paulo,Brazil",
-	"Santa Fe do Sul,State of Sao Paulo,Brazil",
-	"Santa Gertrudes,State of Sao Paulo,Brazil",
-	"Santa Helena,State of Parana,Brazil",
-	"Santa Helena de Goias,State of Goias,Brazil",
-	"Santa Ines,State of Maranhao,Brazil",
-	"Santa Isabel,State of Sao Paulo,Brazil",
-	"Santa Izabel do Oeste,State of Parana,Brazil",
-	"Santa Luzia,State of Minas Gerais,Brazil",
-	"Santa Rita,State of Paraiba,Brazil",
-	"Santa Rita do Passa Quatro,State of Sao Paulo,Brazil",
-	"Santa Teresa,State of Espirito Santo,Brazil",
-	"Santa Terezinha de Itaipu,State of Parana,Brazil",
-	"Santa Vitoria,State of Minas Gerais,Brazil",
-	"Santa Vitoria do Palmar,State of Rio Grande do Sul,Brazil",
-	"Santana,State of Amapa,Brazil",
-	"Santana de Parnaiba,State of Sao Paulo,Brazil",
-	"Santana do Paraiso,State of Minas Gerais,Brazil",
-	"Santiago,State of Rio Grande do Sul,Brazil",
-	"Santo Amaro,State of Bahia,Brazil",
-	"Santo Amaro da Imperatriz,State of Santa Catarina,Brazil",
-	"Santo Anastacio,State of Sao Paulo,Brazil",
-	"Santo Antonio de Posse,State of Sao Paulo,Brazil",
-	"Santo Antonio de Padua,State of Rio de Janeiro,Brazil",
-	"Santo Antonio do Descoberto,State of Goias,Brazil",
-	"Santo Antonio do Sudoeste,State of Parana,Brazil",
-	"Santo Angelo,State of Rio Grande do Sul,Brazil",
-	"Santos Dumont,State of Minas Gerais,Brazil",
-	"Sapiranga,State of Rio Grande do Sul,Brazil",
-	"Sapucaia,State of Rio de Janeiro,Brazil",
-	"Saquarema,State of Rio de Janeiro,Brazil",
-	"Sarandi,State of Parana,Brazil",
-	"Sarzedo,State of Minas Gerais,Brazil",
-	"Satuba,State of Alagoas,Brazil",
-	"Saubara,State of Bahia,Brazil",
-	"Schroeder,State of Santa Catarina,Brazil",
-	"Senador Canedo,State of Goias,Brazil",
-	"Senges,State of Parana,Brazil",
-	"Senhor do Bonfim,State of Bahia,Brazil",
-	"Serra Negra,State of Sao Paulo,Brazil",
-	"Serra Talhada,State of Pernambuco,Brazil",
-	"Serrana,State of Sao Paulo,Brazil",
-	"Serrinha,State of Bahia,Brazil",
-	"Sertanopolis,State of Parana,Brazil",
-	"Sertaozinho,State of Sao Paulo,Brazil",
-	"Sidrolandia,State of Mato Grosso do Sul,Brazil",
-	"Silva Jardim,State of Rio de Janeiro,Brazil",
-	"Siqueira Campos,State of Parana,Brazil",
-	"Socorro,State of Sao Paulo,Brazil",
-	"Soledade,State of Rio Grande do Sul,Brazil",
-	"Sombrio,State of Santa Catarina,Brazil",
-	"Sorriso,State of Mato Grosso,Brazil",
-	"Sousa,State of Paraiba,Brazil",
-	"Sumare,State of Sao Paulo,Brazil",
-	"Sumidouro,State of Rio de Janeiro,Brazil",
-	"Sao Bento do Sul,State of Santa Catarina,Brazil",
-	"Sao Borja,State of Rio Grande do Sul,Brazil",
-	"Sao Cristovao,State of Sergipe,Brazil",
-	"Sao Fidelis,State of Rio de Janeiro,Brazil",
-	"Sao Francisco,State of Minas Gerais,Brazil",
-	"Sao Francisco de Paula,State of Rio Grande do Sul,Brazil",
-	"Sao Francisco do Conde,State of Bahia,Brazil",
-	"Sao Francisco do Sul,State of Santa Catarina,Brazil",
-	"Sao Gabriel,State of Rio Grande do Sul,Brazil",
-	"Sao Gabriel da Palha,State of Espirito Santo,Brazil",
-	"Sao Goncalo,State of Rio de Janeiro,Brazil",
-	"Sao Jeronimo,State of Rio Grande do Sul,Brazil",
-	"Sao Joaquim da Barra,State of Sao Paulo,Brazil",
-	"Sao Joaquim de Bicas,State of Minas Gerais,Brazil",
-	"Sao Jose,State of Santa Catarina,Brazil",
-	"Sao Jose da Laje,State of Alagoas,Brazil",
-	"Sao Jose de Mipibu,State of Rio Grande do Norte,Brazil",
-	"Sao Jose de Ribamar,State of Maranhao,Brazil",
-	"Sao Jose do Rio Pardo,State of Sao Paulo,Brazil",
-	"Sao Jose dos Pinhais,State of Parana,Brazil",
-	"Sao Joao Batista,State of Santa Catarina,Brazil",
-	"Sao Joao da Barra,State of Rio de Janeiro,Brazil",
-	"Sao Joao de Meriti,State of Rio de Janeiro,Brazil",
-	"Sao Lourenco,State of Minas Gerais,Brazil",
-	"Sao Lourenco da Mata,State of Pernambuco,Brazil",
-	"Sao Lourenco da Serra,State of Sao Paulo,Brazil",
-	"Sao Lourenco do Sul,State of Rio Grande do Sul,Brazil",
-	"Sao Luiz Gonzaga,State of Rio Grande do Sul,Brazil",
-	"Sao Luis do Quitunde,State of Alagoas,Brazil",
-	"Sao Manuel,State of Sao Paulo,Brazil",
-	"Sao Marcos,State of Rio Grande do Sul,Brazil",
-	"Sao Miguel Arcanjo,State of Sao Paulo,Brazil",
-	"Sao Miguel do Oeste,State of Santa Catarina,Brazil",
-	"Sao Miguel dos Milagres,State of Alagoas,Brazil",
-	"Sao Pedro,State of Sao Paulo,Brazil",
-	"Sao Pedro da Aldeia,State of Rio de Janeiro,Brazil",
-	"Sao Roque,State of Sao Paulo,Brazil",
-	"Sao Sebastiao do Paraiso,State of Minas Gerais,Brazil",
-	"Sao Sebastiao do Passe,State of Bahia,Brazil",
-	"Sao Simao,State of Sao Paulo,Brazil",
-	"Taboao da Serra,State of Sao Paulo,Brazil",
-	"Tambau,State of Sao Paulo,Brazil",
-	"Tanabi,State of Sao Paulo,Brazil",
-	"Tangua,State of Rio de Janeiro,Brazil",
-	"Taquara,State of Rio Grande do Sul,Brazil",
-	"Taquaritinga,State of Sao Paulo,Brazil",
-	"Taquarituba,State of Sao Paulo,Brazil",
-	"Tatui,State of Sao Paulo,Brazil",
-	"Teixeira de Freitas,State of Bahia,Brazil",
-	"Telemaco Borba,State of Parana,Brazil",
-	"Teodoro Sampaio,State of Sao Paulo,Brazil",
-	"Terra Rica,State of Parana,Brazil",
-	"Teutonia,State of Rio Grande do Sul,Brazil",
-	"Tiangua,State of Ceara,Brazil",
-	"Tiete,State of Sao Paulo,Brazil",
-	"Tijucas,State of Santa Catarina,Brazil",
-	"Timbo,State of Santa Catarina,Brazil",
-	"Timon,State of Maranhao,Brazil",
-	"Timoteo,State of Minas Gerais,Brazil",
-	"Toledo,State of Parana,Brazil",
-	"Tramandai,State of Rio Grande do Sul,Brazil",
-	"Tremembe,State of Sao Paulo,Brazil",
-	"Triunfo,State of Rio Grande do Sul,Brazil",
-	"Tres Passos,State of Rio Grande do Sul,Brazil",
-	"Tres Pontas,State of Minas Gerais,Brazil",
-	"Tres Rios,State of Rio de Janeiro,Brazil",
-	"Tubarao,State of Santa Catarina,Brazil",
-	"Tupaciguara,State of Minas Gerais,Brazil",
-	"Ubatuba,State of Sao Paulo,Brazil",
-	"Ubirata,State of Parana,Brazil",
-	"Uba,State of Minas Gerais,Brazil",
-	"Uniao da Vitoria,State of Parana,Brazil",
-	"Uniao dos Palmares,State of Alagoas,Brazil",
-	"Uruacu,State of Goias,Brazil",
-	"Valenca,State of Bahia,Brazil",
-	"Valinhos,State of Sao Paulo,Brazil",
-	"Vargem Grande do Sul,State of Sao Paulo,Brazil",
-	"Varginha,State of Minas Gerais,Brazil",
-	"Vazante,State of Minas Gerais,Brazil",
-	"Venancio Aires,State of Rio Grande do Sul,Brazil",
-	"Vespasiano,State of Minas Gerais,Brazil",
-	"Viamao,State of Rio Grande do Sul,Brazil",
-	"Viana,State of Espirito Santo,Brazil",
-	"Videira,State of Santa Catarina,Brazil",
-	"Vinhedo,State of Sao Paulo,Brazil",
-	"Vitoria de Santo Antao,State of Pernambuco,Brazil",
-	"Volta Redonda,State of Rio de Janeiro,Brazil",
-	"Votorantim,State of Sao Paulo,Brazil",
-	"Votuporanga,State of Sao Paulo,Brazil",
-	"Varzea Grande,State of Mato Grosso,Brazil",
-	"Varzea Paulista,State of Sao Paulo,Brazil",
-	"Wenceslau Braz,State of Parana,Brazil",
-	"Xanxere,State of Santa Catarina,Brazil",
-	"Xaxim,State of Santa Catarina,Brazil",
-	"Aguas Lindas de Goias,State of Goias,Brazil",
-	"Aguas de Lindoia,State of Sao Paulo,Brazil",
-	"Alvares Machado,State of Sao Paulo,Brazil",
-	"State of Alagoas,Brazil",
-	"State of Amazonas,Brazil",
-	"State of Bahia,Brazil",
-	"State of Ceara,Brazil",
-	"Federal District,Brazil",
-	"State of Espirito Santo,Brazil",
-	"State of Goias,Brazil",
-	"State of Maranhao,Brazil",
-	"State of Minas Gerais,Brazil",
-	"State of Mato Grosso do Sul,Brazil",
-	"State of Mato Grosso,Brazil",
-	"State of Para,Brazil",
-	"State of Paraiba,Brazil",
-	"State of Pernambuco,Brazil",
-	"State of Piaui,Brazil",
-	"State of Parana,Brazil",
-	"State of Rio de Janeiro,Brazil",
-	"State of Rio Grande do Norte,Brazil",
-	"State of Rio Grande do Sul,Brazil",
-	"State of Santa Catarina,Brazil",
-	"State of Sao Paulo,Brazil",
-	"Brazil",
-	"State of Amapa,Brazil",
-	"State of Rondonia,Brazil",
-	"State of Roraima,Brazil",
-	"State of Sergipe,Brazil",
-	"State of Tocantins,Brazil",
-	"State of Acre,Brazil",
-	"Bertram Luiz Leupolz–Sorocaba State Airport,State of Sao Paulo,Brazil",
-	"Afonso Pena International Airport,State of Parana,Brazil",
-	"Bacacheri Airport,State of Parana,Brazil",
-	"Pinto Martins – Fortaleza International Airport,State of Ceara,Brazil",
-	"RIOgaleao - Tom Jobim International Airport,State of Rio de Janeiro,Brazil",
-	"Sao Paulo International Airport,State of Sao Paulo,Brazil",
-	"Recife International Airport,State of Pernambuco,Brazil",
-	"Abelardo Luz,State of Santa Catarina,Brazil",
-	"Agua Boa,State of Mato Grosso,Brazil",
-	"Alpinopolis,State of Minas Gerais,Brazil",
-	"Altonia,State of Parana,Brazil",
-	"Andradas,State of Minas Gerais,Brazil",
-	"Angatuba,State of Sao Paulo,Brazil",
-	"Anicuns,State of Goias,Brazil",
-	"Apodi,State of Rio Grande do Norte,Brazil",
-	"Aracuai,State of Minas Gerais,Brazil",
-	"Armacao dos Buzios,State of Rio de Janeiro,Brazil",
-	"Arroio do Tigre,State of Rio Grande do Sul,Brazil",
-	"Assu,State of Rio Grande do Norte,Brazil",
-	"Baixo Guandu,State of Espirito Santo,Brazil",
-	"Bambui,State of Minas Gerais,Brazil",
-	"Barao de Cocais,State of Minas Gerais,Brazil",
-	"Barracao,State of Parana,Brazil",
-	"Boa Esperanca,State of Minas Gerais,Brazil",
-	"Bom Jesus da Lapa,State of Bahia,Brazil",
-	"Brejo Santo,State of Ceara,Brazil",
-	"Brumadinho,State of Minas Gerais,Brazil",
-	"Buritama,State of Sao Paulo,Brazil",
-	"Cacique Doble,State of Rio Grande do Sul,Brazil",
-	"Caico,State of Rio Grande do Norte,Brazil",
-	"Campo Novo do Parecis,State of Mato Grosso,Brazil",
-	"Campos Gerais,State of Minas Gerais,Brazil",
-	"Canarana,State of Mato Grosso,Brazil",
-	"Capela do Alto,State of Sao Paulo,Brazil",
-	"Capinzal,State of Santa Catarina,Brazil",
-	"Carlopolis,State of Parana,Brazil",
-	"Casca,State of Rio Grande do Sul,Brazil",
-	"Cassia,State of Minas Gerais,Brazil",
-	"Castilho,State of Sao Paulo,Brazil",
-	"Catole do Rocha,State of Paraiba,Brazil",
-	"Cerro Largo,State of Rio Grande do Sul,Brazil",
-	"Cesario Lange,State of Sao Paulo,Brazil",
-	"Ceu Azul,State of Parana,Brazil",
-	"Chapadao do Sul,State of Mato Grosso do Sul,Brazil",
-	"Chavantes,State of Sao Paulo,Brazil",
-	"Clementina,State of Sao Paulo,Brazil",
-	"Colider,State of Mato Grosso,Brazil",
-	"Conceicao de Macabu,State of Rio de Janeiro,Brazil",
-	"Conceicao do Araguaia,State of Para,Brazil",
-	"Conceicao do Mato Dentro,State of Minas Gerais,Brazil",
-	"Cunha Pora,State of Santa Catarina,Brazil",
-	"Currais Novos,State of Rio Grande do Norte,Brazil",
-	"Dois Irmaos,State of Rio Grande do Sul,Brazil",
-	"Domingos Martins,State of Espirito Santo,Brazil",
-	"Doutor Severiano,State of Rio Grande do Norte,Brazil",
-	"Eloi Mendes,State of Minas Gerais,Brazil",
-	"Engenheiro Coelho,State of Sao Paulo,Brazil",
-	"Espera Feliz,State of Minas Gerais,Brazil",
-	"Espumoso,State of Rio Grande do Sul,Brazil",
-	"Estacao,State of Rio Grande do Sul,Brazil",
-	"Feliz,State of Rio Grande do Sul,Brazil",
-	"Fraiburgo,State of Santa Catarina,Brazil",
-	"Getulio Vargas,State of Rio Grande do Sul,Brazil",
-	"Goiatuba,State of Goias,Brazil",
-	"Guarabira,State of Paraiba,Brazil",
-	"Ilha Solteira,State of Sao Paulo,Brazil",
-	"Inhambupe,State of Bahia,Brazil",
-	"Ipora,State of Goias,Brazil",
-	"Itabaianinha,State of Sergipe,Brazil",
-	"Itambe,State of Pernambuco,Brazil",
-	"Itapiranga,State of Santa Catarina,Brazil",
-	"Itaporanga,State of Paraiba,Brazil",
-	"Ituporanga,State of Santa Catarina,Brazil",
-	"Jaboatao dos Guararapes,State of Pernambuco,Brazil",
-	"Jaragua,State of Goias,Brazil",
-	"Joao Pinheiro,State of Minas Gerais,Brazil",
-	"Juina,State of Mato Grosso,Brazil",
-	"Juruaia,State of Minas Gerais,Brazil",
-	"Lucas do Rio Verde,State of Mato Grosso,Brazil",
-	"Luis Eduardo Magalhaes,State of Bahia,Brazil",
-	"Macaubas,State of Bahia,Brazil",
-	"Machado,State of Minas Gerais,Brazil",
-	"Manhumirim,State of Minas Gerais,Brazil",
-	"Maravilha,State of Santa Catarina,Brazil",
-	"Mauriti,State of Ceara,Brazil",
-	"Monteiro,State of Paraiba,Brazil",
-	"Muzambinho,State of Minas Gerais,Brazil",
-	"Nao-Me-Toque,State of Rio Grande do Sul,Brazil",
-	"Natuba,State of Paraiba,Brazil",
-	"Nepomuceno,State of Minas Gerais,Brazil",
-	"Niquelandia,State of Goias,Brazil",
-	"Nova Mutum,State of Mato Grosso,Brazil",
-	"Nova Petropolis,State of Rio Grande do Sul,Brazil",
-	"Nova Vicosa,State of Bahia,Brazil",
-	"Orleans,State of Santa Catarina,Brazil",
-	"Palmares,State of Pernambuco,Brazil",
-	"Palmitos,State of Santa Catarina,Brazil",
-	"Paraguacu,State of Minas Gerais,Brazil",
-	"Paraisopolis,State of Minas Gerais,Brazil",
-	"Parelhas,State of Rio Grande do Norte,Brazil",
-	"Pau dos Ferros,State of Rio Grande do Norte,Brazil",
-	"Penedo,State of Alagoas,Brazil",
-	"Perdoes,State of Minas Gerais,Brazil",
-	"Pesqueira,State of Pernambuco,Brazil",
-	"Picarras,State of Santa Catarina,Brazil",
-	"Picui,State of Paraiba,Brazil",
-	"Pinhalzinho,State of Santa Catarina,Brazil",
-	"Pirapozinho,State of Sao Paulo,Brazil",
-	"Piumhi,State of Minas Gerais,Brazil",
-	"Poco Fundo,State of Minas Gerais,Brazil",
-	"Porto Uniao,State of Santa Catarina,Brazil",
-	"Pouso Alto,State of Minas Gerais,Brazil",
-	"Presidente Getulio,State of Santa Catarina,Brazil",
-	"Querencia,State of Mato Grosso,Brazil",
-	"Reriutaba,State of Ceara,Brazil",
-	"Rio Bananal,State of Espirito Santo,Brazil",
-	"Rondon,State of Parana,Brazil",
-	"Russas,State of Ceara,Brazil",
-	"Sananduva,State of Rio Grande do Sul,Brazil",
-	"Santa Cruz,State of Rio Grande do Norte,Brazil",
-	"Santa Luzia,State of Paraiba,Brazil",
-	"Santa Maria de Jetiba,State of Espirito Santo,Brazil",
-	"Santaluz,State of Bahia,Brazil",
-	"Santana do Ipanema,State of Alagoas,Brazil",
-	"Santo Antonio da Patrulha,State of Rio Grande do Sul,Brazil",
-	"Sao Benedito,State of Ceara,Brazil",
-	"Sao Joao do Ivai,State of Parana,Brazil",
-	"Sao Jose de Piranhas,State of Paraiba,Brazil",
-	"Sao Lourenco do Oeste,State of Santa Catarina,Brazil",
-	"Sao Miguel,State of Rio Grande do Norte,Brazil",
-	"Sao Miguel do Iguacu,State of Parana,Brazil",
-	"Sao Sebastiao do Cai,State of Rio Grande do Sul,Brazil",
-	"Sapucaia do Sul,State of Rio Grande do Sul,Brazil",
-	"Sarandi,State of Rio Grande do Sul,Brazil",
-	"Seabra,State of Bahia,Brazil",
-	"Serafina Correa,State of Rio Grande do Sul,Brazil",
-	"Simao Dias,State of Sergipe,Brazil",
-	"Sobradinho,State of Rio Grande do Sul,Brazil",
-	"Tapejara,State of Rio Grande do Sul,Brazil",
-	"Tapira,State of Parana,Brazil",
-	"Terra Roxa,State of Parana,Brazil",
-	"Timbauba,State of Pernambuco,Brazil",
-	"Tobias Barreto,State of Sergipe,Brazil",
-	"Tres de Maio,State of Rio Grande do Sul,Brazil",
-	"Tres Marias,State of Minas Gerais,Brazil",
-	"Tupi Paulista,State of Sao Paulo,Brazil",
-	"Turvo,State of Santa Catarina,Brazil",
-	"Valentim Gentil,State of Sao Paulo,Brazil",
-	"Vargem Alta,State of Espirito Santo,Brazil",
-	"Vargem Grande Paulista,State of Sao Paulo,Brazil",
-	"Venda Nova do Imigrante,State of Espirito Santo,Brazil",
-	"Vilhena,State of Rondonia,Brazil",
-	"Visconde do Rio Branco,State of Minas Gerais,Brazil",
-	"Xinguara,State of Para,Brazil",
-	"Mirassol,Mirassol,State of Sao Paulo,Brazil",
-	"Bady Bassitt,State of Sao Paulo,Brazil",
-	"Lavras,Lavras,State of Minas Gerais,Brazil",
-	"Carazinho,Carazinho,State of Rio Grande do Sul,Brazil",
-	"Itatiba,Itatiba,State of Sao Paulo,Brazil",
-	"Marechal Deodoro,State of Alagoas,Brazil",
-	"Alexania,State of Goias,Brazil",
-	"Aquiraz,Aquiraz,State of Ceara,Brazil",
-	"Belo Jardim,State of Pernambuco,Brazil",
-	"Inhumas,State of Goias,Brazil",
-	"Cajati,Cajati,State of Sao Paulo,Brazil",
-	"Penha,Penha,State of Santa Catarina,Brazil",
-	"Concordia,Concordia,State of Santa Catarina,Brazil",
-	"Sao Jose de Mipibu,Sao Jose de Mipibu,State of Rio Grande do Norte,Brazil",
-	"Rio Grande,Rio Grande,State of Rio Grande do Sul,Brazil",
-	"Guaratuba,Guaratuba,State of Parana,Brazil",
-	"Nova Odessa,Nova Odessa,State of Sao Paulo,Brazil",
-	"Aracati,Aracati,State of Ceara,Brazil",
-	"Moji-Mirim,Moji-Mirim,State of Sao Paulo,Brazil",
-	"Presidente Venceslau,Presidente Venceslau,State of Sao Paulo,Brazil",
-	"Bonito,State of Pernambuco,Brazil",
-	"Sao Miguel dos Campos,State of Alagoas,Brazil",
-	"Mateus Leme,Mateus Leme,State of Minas Gerais,Brazil",
-	"Matao,Matao,State of Sao Paulo,Brazil",
-	"Batatais,Batatais,State of Sao Paulo,Brazil",
-	"Aracoiaba da Serra,Aracoiaba da Serra,State of Sao Paulo,Brazil",
-	"Votorantim,Votorantim,State of Sao Paulo,Brazil",
-	"Goiana,Goiana,State of Pernambuco,Brazil",
-	"Porto Belo,Porto Belo,State of Santa Catarina,Brazil",
-	"Campina Grande do Sul,Campina Grande do Sul,State of Parana,Brazil",
-	"Santa Isabel do Para,State of Para,Brazil",
-	"Mairinque,Mairinque,State of Sao Paulo,Brazil",
-	"Penapolis,Penapolis,State of Sao Paulo,Brazil",
-	"Ararica,State of Rio Grande do Sul,Brazil",
-	"Pederneiras,Pederneiras,State of Sao Paulo,Brazil",
-	"Silva Jardim,Silva Jardim,State of Rio de Janeiro,Brazil",
-	"Limeira,Limeira,State of Sao Paulo,Brazil",
-	"Cornelio Procopio,Cornelio Procopio,State of Parana,Brazil",
-	"Fazenda Rio Grande,State of Parana,Brazil",
-	"Pacajus,State of Ceara,Brazil",
-	"Olimpia,Olimpia,State of Sao Paulo,Brazil",
-	"Cachoeira Paulista,Cachoeira Paulista,State of Sao Paulo,Brazil",
-	"Terra de Areia,State of Rio Grande do Sul,Brazil",
-	"Barra do Pirai,Barra do Pirai,State of Rio de Janeiro,Brazil",
-	"Castro,Castro,State of Parana,Brazil",
-	"Taquaritinga,Taquaritinga,State of Sao Paulo,Brazil",
-	"Pirassununga,Pirassununga,State of Sao Paulo,Brazil",
-	"Matozinhos,Matozinhos,State of Minas Gerais,Brazil",
-	"Jaguariuna,Jaguariuna,State of Sao Paulo,Brazil",
-	"Horizonte,State of Ceara,Brazil",
-	"Rio Largo,Rio Largo,State of Alagoas,Brazil",
-	"Ubatuba,Ubatuba,State of Sao Paulo,Brazil",
-	"Moreno,Moreno,State of Pernambuco,Brazil",
-	"Mandaguari,Mandaguari,State of Parana,Brazil",
-	"Aracruz,Aracruz,State of Espirito Santo,Brazil",
-	"Ibiuna,Ibiuna,State of Sao Paulo,Brazil",
-	"Acarau,State of Ceara,Brazil",
-	"Perdoes,Perdoes,State of Minas Gerais,Brazil",
-	"Tres Coracoes,Tres Coracoes,State of Minas Gerais,Brazil",
-	"Estancia,Estancia,State of Sergipe,Brazil",
-	"Jacarei,Jacarei,State of Sao Paulo,Brazil",
-	"Promissao,Promissao,State of Sao Paulo,Brazil",
-	"Pindamonhangaba,Pindamonhangaba,State of Sao Paulo,Brazil",
-	"Capivari,Capivari,State of Sao Paulo,Brazil",
-	"Extrema,Extrema,State of Minas Gerais,Brazil",
-	"Venancio Aires,Venancio Aires,State of Rio Grande do Sul,Brazil",
-	"Ilheus,Ilheus,State of Bahia,Brazil",
-	"Sao Francisco do Sul,Sao Francisco do Sul,State of Santa Catarina,Brazil",
-	"Paranagua,Paranagua,State of Parana,Brazil",
-	"Monte Mor,Monte Mor,State of Sao Paulo,Brazil",
-	"Paraty,Paraty,State of Rio de Janeiro,Brazil",
-	"Marituba,State of Para,Brazil",
-	"Santo Antonio da Patrulha,Santo Antonio da Patrulha,State of Rio Grande do Sul,Brazil",
-	"Bom Despacho,Bom Despacho,State of Minas Gerais,Brazil",
-	"Morrinhos,Morrinhos,State of Goias,Brazil",
-	"Surubim,State of Pernambuco,Brazil",
-	"Camacari,Camacari,State of Bahia,Brazil",
-	"Avare,Avare,State of Sao Paulo,Brazil",
-	"Sao Jose da Lapa,State of Minas Gerais,Brazil",
-	"Trindade,Trindade,State of Goias,Brazil",
-	"Cosmopolis,Cosmopolis,State of Sao Paulo,Brazil",
-	"Rolandia,Rolandia,State of Parana,Brazil",
-	"Itapeva,State of Minas Gerais,Brazil",
-	"Guararapes,Guararapes,State of Sao Paulo,Brazil",
-	"Valinhos,Valinhos,State of Sao Paulo,Brazil",
-	"Novo Gama,State of Goias,Brazil",
-	"Lajedo,State of Pernambuco,Brazil",
-	"Jose Bonifacio,Jose Bonifacio,State of Sao Paulo,Brazil",
-	"Itatiaia,Itatiaia,State of Rio de Janeiro,Brazil",
-	"Ouro Preto,Ouro Preto,State of Minas Gerais,Brazil",
-	"Paraguacu Paulista,Paraguacu Paulista,State of Sao Paulo,Brazil",
-	"Sao Joaquim da Barra,Sao Joaquim da Barra,State of Sao Paulo,Brazil",
-	"Toritama,State of Pernambuco,Brazil",
-	"Brotas,Brotas,State of Sao Paulo,Brazil",
-	"Nova Esperanca,State of Parana,Brazil",
-	"Pirai,Pirai,State of Rio de Janeiro,Brazil",
-	"Cruz das Almas,State of Bahia,Brazil",
-	"Orlandia,Orlandia,State of Sao Paulo,Brazil",
-	"Santo Antonio do Descoberto,Santo Antonio do Descoberto,State of Goias,Brazil",
-	"Cabedelo,State of Paraiba,Brazil",
-	"Santa Isabel,Santa Isabel,State of Sao Paulo,Brazil",
-	"Mamanguape,State of Paraiba,Brazil",
-	"Itupeva,Itupeva,State of Sao Paulo,Brazil",
-	"Porto Feliz,Porto Feliz,State of Sao Paulo,Brazil",
-	"Santa Rosa do Sul,State of Santa Catarina,Brazil",
-	"Mococa,Mococa,State of Sao Paulo,Brazil",
-	"Osorio,Osorio,State of Rio Grande do Sul,Brazil",
-	"Ibitinga,Ibitinga,State of Sao Paulo,Brazil",
-	"Sao Roque,Sao Roque,State of Sao Paulo,Brazil",
-	"Santo Estevao,State of Bahia,Brazil",
-	"Irece,State of Bahia,Brazil",
-	"Valparaiso de Goias,State of Goias,Brazil",
-	"Artur Nogueira,Artur Nogueira,State of Sao Paulo,Brazil",
-	"Casimiro de Abreu,Casimiro de Abreu,State of Rio de Janeiro,Brazil",
-	"Tres Coroas,State of Rio Grande do Sul,Brazil",
-	"Jandira,Jandira,State of Sao Paulo,Brazil",
-	"Propria,State of Sergipe,Brazil",
-	"Limoeiro do Norte,State of Ceara,Brazil",
-	"Garibaldi,Garibaldi,State of Rio Grande do Sul,Brazil",
-	"Garca,Garca,State of Sao Paulo,Brazil",
-	"Simoes Filho,Simoes Filho,State of Bahia,Brazil",
-	"Vinhedo,Vinhedo,State of Sao Paulo,Brazil",
-	"Planaltina,State of Goias,Brazil",
-	"Piedade,Piedade,State of Sao Paulo,Brazil",
-	"Brodowski,State of Sao Paulo,Brazil",
-	"Sao Pedro,Sao Pedro,State of Sao Paulo,Brazil",
-	"Manhuacu,Manhuacu,State of Minas Gerais,Brazil",
-	"Torres,Torres,State of Rio Grande do Sul,Brazil",
-	"Pedro Leopoldo,Pedro Leopoldo,State of Minas Gerais,Brazil",
-	"Boituva,Boituva,State of Sao Paulo,Brazil",
-	"Pontal do Parana,Pontal do Parana,State of Parana,Brazil",
-	"Santa Cruz do Rio Pardo,Santa Cruz do Rio Pardo,State of Sao Paulo,Brazil",
-	"Roseira,State of Sao Paulo,Brazil",
-	"Maranguape,Maranguape,State of Ceara,Brazil",
-	"Sao Goncalo do Amarante,State of Rio Grande do Norte,Brazil",
-	"Quadra,State of Sao Paulo,Brazil",
-	"Araguari,Araguari,State of Minas Gerais,Brazil",
-	"Agudos,Agudos,State of Sao Paulo,Brazil",
-	"Cravinhos,Cravinhos,State of Sao Paulo,Brazil",
-	"Paudalho,State of Pernambuco,Brazil",
-	"Cristalina,Cristalina,State of Goias,Brazil",
-	"Bom Principio,State of Rio Grande do Sul,Brazil",
-	"Nova Santa Rita,State of Rio Grande do Sul,Brazil",
-	"Marialva,Marialva,State of Parana,Brazil",
-	"Sabara,Sabara,State of Minas Gerais,Brazil",
-	"Itabirito,Itabirito,State of Minas Gerais,Brazil",
-	"Itaguai,Itaguai,State of Rio de Janeiro,Brazil",
-	"Quatro Barras,Quatro Barras,State of Parana,Brazil",
-	"Candeias,Candeias,State of Bahia,Brazil",
-	"Cascavel,State of Ceara,Brazil",
-	"Sao Manuel,Sao Manuel,State of Sao Paulo,Brazil",
-	"Montenegro,Montenegro,State of Rio Grande do Sul,Brazil",
-	"Bayeux,Bayeux,State of Paraiba,Brazil",
-	"Salgueiro,State of Pernambuco,Brazil",
-	"Ibate,Ibate,State of Sao Paulo,Brazil",
-	"Santana do Livramento,Santana do Livramento,State of Rio Grande do Sul,Brazil",
-	"Mairipora,Mairipora,State of Sao Paulo,Brazil",
-	"Carambei,Carambei,State of Parana,Brazil",
-	"Aracariguama,State of Sao Paulo,Brazil",
-	"Camanducaia,Camanducaia,State of Minas Gerais,Brazil",
-	"Assu,Assu,State of Rio Grande do Norte,Brazil",
-	"Paco do Lumiar,State of Maranhao,Brazil",
-	"Cidade Ocidental,State of Goias,Brazil",
-	"Porto Seguro,Porto Seguro,State of Bahia,Brazil",
-	"Alem Paraiba,Alem Paraiba,State of Minas Gerais,Brazil",
-	"Santa Gertrudes,Santa Gertrudes,State of Sao Paulo,Brazil",
-	"Confins,State of Minas Gerais,Brazil",
-	"Macaiba,Macaiba,State of Rio Grande do Norte,Brazil",
-	"Xangri-la,State of Rio Grande do Sul,Brazil",
-	"Garuva,State of Santa Catarina,Brazil",
-	"Abadiania,State of Goias,Brazil",
-	"Ibipora,Ibipora,State of Parana,Brazil",
-	"Tremembe,Tremembe,State of Sao Paulo,Brazil",
-	"Amargosa,State of Bahia,Brazil",
-	"Franco da Rocha,Franco da Rocha,State of Sao Paulo,Brazil",
-	"Mogi das Cruzes,Mogi das Cruzes,State of Sao Paulo,Brazil",
-	"Arraial do Cabo,Arraial do Cabo,State of Rio de Janeiro,Brazil",
-	"Resende,Resende,State of Rio de Janeiro,Brazil",
-	"Timon,Timon,State of Maranhao,Brazil",
-	"Araruama,Araruama,State of Rio de Janeiro,Brazil",
-	"Jacupiranga,State of Sao Paulo,Brazil",
-	"Cambui,Cambui,State of Minas Gerais,Brazil",
-	"Miracatu,State of Sao Paulo,Brazil",
-	"Caratinga,Caratinga,State of Minas Gerais,Brazil",
-	"02286,State of Sao Paulo,Brazil",
-	"04849,State of Sao Paulo,Brazil",
-	"04856,State of Sao Paulo,Brazil",
-	"04857,State of Sao Paulo,Brazil",
-	"04866,State of Sao Paulo,Brazil",
-	"04870,State of Sao Paulo,Brazil",
-	"04872,State of Sao Paulo,Brazil",
-	"04874,State of Sao Paulo,Brazil",
-	"04875,State of Sao Paulo,Brazil",
-	"04877,State of Sao Paulo,Brazil",
-	"04880,State of Sao Paulo,Brazil",
-	"04882,State of Sao Paulo,Brazil",
-	"04883,State of Sao Paulo,Brazil",
-	"04884,State of Sao Paulo,Brazil",
-	"04886,State of Sao Paulo,Brazil",
-	"04888,State of Sao Paulo,Brazil",
-	"04889,State of Sao Paulo,Brazil",
-	"04890,State of Sao Paulo,Brazil",
-	"04891,State of Sao Paulo,Brazil",
-	"04892,State of Sao Paulo,Brazil",
-	"04893,State of Sao Paulo,Brazil",
-	"04894,State of Sao Paulo,Brazil",
-	"04897,State of Sao Paulo,Brazil",
-	"05210,State of Sao Paulo,Brazil",
-	"06407,State of Sao Paulo,Brazil",
-	"06429,State of Sao Paulo,Brazil",
-	"06501,State of Sao Paulo,Brazil",
-	"06503,State of Sao Paulo,Brazil",
-	"06504,State of Sao Paulo,Brazil",
-	"06505,State of Sao Paulo,Brazil",
-	"06509,State of Sao Paulo,Brazil",
-	"06514,State of Sao Paulo,Brazil",
-	"06515,State of Sao Paulo,Brazil",
-	"06517,State of Sao Paulo,Brazil",
-	"06520,State of Sao Paulo,Brazil",
-	"06523,State of Sao Paulo,Brazil",
-	"06524,State of Sao Paulo,Brazil",
-	"06525,State of Sao Paulo,Brazil",
-	"06526,State of Sao Paulo,Brazil",
-	"06528,State of Sao Paulo,Brazil",
-	"06532,State of Sao Paulo,Brazil",
-	"06533,State of Sao Paulo,Brazil",
-	"06534,State of Sao Paulo,Brazil",
-	"06537,State of Sao Paulo,Brazil",
-	"06540,State of Sao Paulo,Brazil",
-	"06541,State of Sao Paulo,Brazil",
-	"06544,State of Sao Paulo,Brazil",
-	"06665,State of Sao Paulo,Brazil",
-	"06670,State of Sao Paulo,Brazil",
-	"06673,State of Sao Paulo,Brazil",
-	"06675,State of Sao Paulo,Brazil",
-	"06680,State of Sao Paulo,Brazil",
-	"06690,State of Sao Paulo,Brazil",
-	"06696,State of Sao Paulo,Brazil",
-	"06700,State of Sao Paulo,Brazil",
-	"06701,State of Sao Paulo,Brazil",
-	"06702,State of Sao Paulo,Brazil",
-	"06703,State of Sao Paulo,Brazil",
-	"06704,State of Sao Paulo,Brazil",
-	"06705,State of Sao Paulo,Brazil",
-	"06708,State of Sao Paulo,Brazil",
-	"06710,State of Sao Paulo,Brazil",
-	"06715,State of Sao Paulo,Brazil",
-	"06716,State of Sao Paulo,Brazil",
-	"06717,State of Sao Paulo,Brazil",
-	"06719,State of Sao Paulo,Brazil",
-	"06720,State of Sao Paulo,Brazil",
-	"06721,State of Sao Paulo,Brazil",
-	"06722,State of Sao Paulo,Brazil",
-	"06723,State of Sao Paulo,Brazil",
-	"06724,State of Sao Paulo,Brazil",
-	"06725,State of Sao Paulo,Brazil",
-	"06726,State of Sao Paulo,Brazil",
-	"06727,State of Sao Paulo,Brazil",
-	"06728,State of Sao Paulo,Brazil",
-	"06729,State of Sao Paulo,Brazil",
-	"06835,State of Sao Paulo,Brazil",
-	"06843,State of Sao Paulo,Brazil",
-	"06844,State of Sao Paulo,Brazil",
-	"06845,State of Sao Paulo,Brazil",
-	"06846,State of Sao Paulo,Brazil",
-	"06851,State of Sao Paulo,Brazil",
-	"06858,State of Sao Paulo,Brazil",
-	"06860,State of Sao Paulo,Brazil",
-	"06861,State of Sao Paulo,Brazil",
-	"06864,State of Sao Paulo,Brazil",
-	"06867,State of Sao Paulo,Brazil",
-	"06868,State of Sao Paulo,Brazil",
-	"06869,State of Sao Paulo,Brazil",
-	"06870,State of Sao Paulo,Brazil",
-	"06871,State of Sao Paulo,Brazil",
-	"06873,State of Sao Paulo,Brazil",
-	"06874,State of Sao Paulo,Brazil",
-	"06876,State of Sao Paulo,Brazil",
-	"06880,State of Sao Paulo,Brazil",
-	"06881,State of Sao Paulo,Brazil",
-	"06882,State of Sao Paulo,Brazil",
-	"06885,State of Sao Paulo,Brazil",
-	"06886,State of Sao Paulo,Brazil",
-	"06888,State of Sao Paulo,Brazil",
-	"07085,State of Sao Paulo,Brazil",
-	"07135,State of Sao Paulo,Brazil",
-	"07140,State of Sao Paulo,Brazil",
-	"07142,State of Sao Paulo,Brazil",
-	"07144,State of Sao Paulo,Brazil",
-	"07145,State of Sao Paulo,Brazil",
-	"07152,State of Sao Paulo,Brazil",
-	"07155,State of Sao Paulo,Brazil",
-	"07158,State of Sao Paulo,Brazil",
-	"07174,State of Sao Paulo,Brazil",
-	"07176,State of Sao Paulo,Brazil",
-	"07178,State of Sao Paulo,Brazil",
-	"07179,State of Sao Paulo,Brazil",
-	"07181,State of Sao Paulo,Brazil",
-	"07183,State of Sao Paulo,Brazil",
-	"07803,State of Sao Paulo,Brazil",
-	"07810,State of Sao Paulo,Brazil",
-	"07811,State of Sao Paulo,Brazil",
-	"07834,State of Sao Paulo,Brazil",
-	"07852,State of Sao Paulo,Brazil",
-	"07856,State of Sao Paulo,Brazil",
-	"07857,State of Sao Paulo,Brazil",
-	"07863,State of Sao Paulo,Brazil",
-	"07955,State of Sao Paulo,Brazil",
-	"07985,State of Sao Paulo,Brazil",
-	"08260,State of Sao Paulo,Brazil",
-	"08265,State of Sao Paulo,Brazil",
-	"08370,State of Sao Paulo,Brazil",
-	"08373,State of Sao Paulo,Brazil",
-	"08382,State of Sao Paulo,Brazil",
-	"08383,State of Sao Paulo,Brazil",
-	"08421,State of Sao Paulo,Brazil",
-	"08471,State of Sao Paulo,Brazil",
-	"08485,State of Sao Paulo,Brazil",
-	"08579,State of Sao Paulo,Brazil",
-	"08582,State of Sao Paulo,Brazil",
-	"08584,State of Sao Paulo,Brazil",
-	"08586,State of Sao Paulo,Brazil",
-	"08590,State of Sao Paulo,Brazil",
-	"08591,State of Sao Paulo,Brazil",
-	"08592,State of Sao Paulo,Brazil",
-	"08595,State of Sao Paulo,Brazil",
-	"08597,State of Sao Paulo,Brazil",
-	"08599,State of Sao Paulo,Brazil",
-	"08616,State of Sao Paulo,Brazil",
-	"08620,State of Sao Paulo,Brazil",
-	"08621,State of Sao Paulo,Brazil",
-	"08625,State of Sao Paulo,Brazil",
-	"08633,State of Sao Paulo,Brazil",
-	"08640,State of Sao Paulo,Brazil",
-	"08645,State of Sao Paulo,Brazil",
-	"08650,State of Sao Paulo,Brazil",
-	"08653,State of Sao Paulo,Brazil",
-	"08655,State of Sao Paulo,Brazil",
-	"08663,State of Sao Paulo,Brazil",
-	"08666,State of Sao Paulo,Brazil",
-	"08692,State of Sao Paulo,Brazil",
-	"08725,State of Sao Paulo,Brazil",
-	"08726,State of Sao Paulo,Brazil",
-	"08745,State of Sao Paulo,Brazil",
-	"08751,State of Sao Paulo,Brazil",
-	"08753,State of Sao Paulo,Brazil",
-	"08775,State of Sao Paulo,Brazil",
-	"08780,State of Sao Paulo,Brazil",
-	"08793,State of Sao Paulo,Brazil",
-	"08810,State of Sao Paulo,Brazil",
-	"08820,State of Sao Paulo,Brazil",
-	"08840,State of Sao Paulo,Brazil",
-	"08850,State of Sao Paulo,Brazil",
-	"09134,State of Sao Paulo,Brazil",
-	"09160,State of Sao Paulo,Brazil",
-	"09165,State of Sao Paulo,Brazil",
-	"09360,State of Sao Paulo,Brazil",
-	"09381,State of Sao Paulo,Brazil",
-	"09414,State of Sao Paulo,Brazil",
-	"09433,State of Sao Paulo,Brazil",
-	"09436,State of Sao Paulo,Brazil",
-	"09440,State of Sao Paulo,Brazil",
-	"09444,State of Sao Paulo,Brazil",
-	"09445,State of Sao Paulo,Brazil",
-	"09791,State of Sao Paulo,Brazil",
-	"09830,State of Sao Paulo,Brazil",
-	"09831,State of Sao Paulo,Brazil",
-	"09832,State of Sao Paulo,Brazil",
-	"09835,State of Sao Paulo,Brazil",
-	"09836,State of Sao Paulo,Brazil",
-	"09837,State of Sao Paulo,Brazil",
-	"09838,State of Sao Paulo,Brazil",
-	"09843,State of Sao Paulo,Brazil",
-	"09847,State of Sao Paulo,Brazil",
-	"09854,State of Sao Paulo,Brazil",
-	"11347,State of Sao Paulo,Brazil",
-	"11421,State of Sao Paulo,Brazil",
-	"11432,State of Sao Paulo,Brazil",
-	"11440,State of Sao Paulo,Brazil",
-	"11441,State of Sao Paulo,Brazil",
-	"11443,State of Sao Paulo,Brazil",
-	"11445,State of Sao Paulo,Brazil",
-	"11500,State of Sao Paulo,Brazil",
-	"11505,State of Sao Paulo,Brazil",
-	"11550,State of Sao Paulo,Brazil",
-	"11570,State of Sao Paulo,Brazil",
-	"11660,State of Sao Paulo,Brazil",
-	"11661,State of Sao Paulo,Brazil",
-	"11662,State of Sao Paulo,Brazil",
-	"11664,State of Sao Paulo,Brazil",
-	"11671,State of Sao Paulo,Brazil",
-	"11672,State of Sao Paulo,Brazil",
-	"11676,State of Sao Paulo,Brazil",
-	"11677,State of Sao Paulo,Brazil",
-	"11700,State of Sao Paulo,Brazil",
-	"11701,State of Sao Paulo,Brazil",
-	"11711,State of Sao Paulo,Brazil",
-	"11712,State of Sao Paulo,Brazil",
-	"11713,State of Sao Paulo,Brazil",
-	"12032,State of Sao Paulo,Brazil",
-	"12041,State of Sao Paulo,Brazil",
-	"12042,State of Sao Paulo,Brazil",
-	"12043,State of Sao Paulo,Brazil",
-	"12045,State of Sao Paulo,Brazil",
-	"12051,State of Sao Paulo,Brazil",
-	"12053,State of Sao Paulo,Brazil",
-	"12072,State of Sao Paulo,Brazil",
-	"12085,State of Sao Paulo,Brazil",
-	"12086,State of Sao Paulo,Brazil",
-	"12090,State of Sao Paulo,Brazil",
-	"12092,State of Sao Paulo,Brazil",
-	"12093,State of Sao Paulo,Brazil",
-	"12095,State of Sao Paulo,Brazil",
-	"12120,State of Sao Paulo,Brazil",
-	"12209,State of Sao Paulo,Brazil",
-	"12210,State of Sao Paulo,Brazil",
-	"12211,State of Sao Paulo,Brazil",
-	"12212,State of Sao Paulo,Brazil",
-	"12213,State of Sao Paulo,Brazil",
-	"12214,State of Sao Paulo,Brazil",
-	"12215,State of Sao Paulo,Brazil",
-	"12220,State of Sao Paulo,Brazil",
-	"12221,State of Sao Paulo,Brazil",
-	"12223,State of Sao Paulo,Brazil",
-	"12225,State of Sao Paulo,Brazil",
-	"12226,State of Sao Paulo,Brazil",
-	"12227,State of Sao Paulo,Brazil",
-	"12228,State of Sao Paulo,Brazil",
-	"12229,State of Sao Paulo,Brazil",
-	"12231,State of Sao Paulo,Brazil",
-	"12232,State of Sao Paulo,Brazil",
-	"12233,State of Sao Paulo,Brazil",
-	"12234,State of Sao Paulo,Brazil",
-	"12238,State of Sao Paulo,Brazil",
-	"12239,State of Sao Paulo,Brazil",
-	"12241,State of Sao Paulo,Brazil",
-	"12242,State of Sao Paulo,Brazil",
-	"12244,State of Sao Paulo,Brazil",
-	"12246,State of Sao Paulo,Brazil",
-	"12247,State of Sao Paulo,Brazil",
-	"12248,State of Sao Paulo,Brazil",
-	"12283,State of Sao Paulo,Brazil",
-	"12284,State of Sao Paulo,Brazil",
-	"12285,State of Sao Paulo,Brazil",
-	"12286,State of Sao Paulo,Brazil",
-	"12287,State of Sao Paulo,Brazil",
-	"12289,State of Sao Paulo,Brazil",
-	"12290,State of Sao Paulo,Brazil",
-	"12295,State of Sao Paulo,Brazil",
-	"12301,State of Sao Paulo,Brazil",
-	"12305,State of Sao Paulo,Brazil",
-	"12309,State of Sao Paulo,Brazil",
-	"12311,State of Sao Paulo,Brazil",
-	"12312,State of Sao Paulo,Brazil",
-	"12316,State of Sao Paulo,Brazil",
-	"12318,State of Sao Paulo,Brazil",
-	"12319,State of Sao Paulo,Brazil",
-	"12323,State of Sao Paulo,Brazil",
-	"12324,State of Sao Paulo,Brazil",
-	"12326,State of Sao Paulo,Brazil",
-	"12328,State of Sao Paulo,Brazil",
-	"12329,State of Sao Paulo,Brazil",
-	"12331,State of Sao Paulo,Brazil",
-	"12332,State of Sao Paulo,Brazil",
-	"12334,State of Sao Paulo,Brazil",
-	"12335,State of Sao Paulo,Brazil",
-	"12341,State of Sao Paulo,Brazil",
-	"12411,State of Sao Paulo,Brazil",
-	"12412,State of Sao Paulo,Brazil",
-	"12413,State of Sao Paulo,Brazil",
-	"12414,State of Sao Paulo,Brazil",
-	"12415,State of Sao Paulo,Brazil",
-	"12423,State of Sao Paulo,Brazil",
-	"12424,State of Sao Paulo,Brazil",
-	"12440,State of Sao Paulo,Brazil",
-	"12441,State of Sao Paulo,Brazil",
-	"12442,State of Sao Paulo,Brazil",
-	"12443,State of Sao Paulo,Brazil",
-	"12444,State of Sao Paulo,Brazil",
-	"12446,State of Sao Paulo,Brazil",
-	"12503,State of Sao Paulo,Brazil",
-	"12507,State of Sao Paulo,Brazil",
-	"12508,State of Sao Paulo,Brazil",
-	"12509,State of Sao Paulo,Brazil",
-	"12510,State of Sao Paulo,Brazil",
-	"12512,State of Sao Paulo,Brazil",
-	"12513,State of Sao Paulo,Brazil",
-	"12519,State of Sao Paulo,Brazil",
-	"12520,State of Sao Paulo,Brazil",
-	"12521,State of Sao Paulo,Brazil",
-	"12523,State of Sao Paulo,Brazil",
-	"12524,State of Sao Paulo,Brazil",
-	"12600,State of Sao Paulo,Brazil",
-	"12601,State of Sao Paulo,Brazil",
-	"12602,State of Sao Paulo,Brazil",
-	"12603,State of Sao Paulo,Brazil",
-	"12604,State of Sao Paulo,Brazil",
-	"12606,State of Sao Paulo,Brazil",
-	"12609,State of Sao Paulo,Brazil",
-	"12702,State of Sao Paulo,Brazil",
-	"12703,State of Sao Paulo,Brazil",
-	"12704,State of Sao Paulo,Brazil",
-	"12711,State of Sao Paulo,Brazil",
-	"12712,State of Sao Paulo,Brazil",
-	"12721,State of Sao Paulo,Brazil",
-	"12730,State of Sao Paulo,Brazil",
-	"12903,State of Sao Paulo,Brazil",
-	"12908,State of Sao Paulo,Brazil",
-	"12910,State of Sao Paulo,Brazil",
-	"12914,State of Sao Paulo,Brazil",
-	"12917,State of Sao Paulo,Brazil",
-	"12918,State of Sao Paulo,Brazil",
-	"12919,State of Sao Paulo,Brazil",
-	"12924,State of Sao Paulo,Brazil",
-	"12926,State of Sao Paulo,Brazil",
-	"12929,State of Sao Paulo,Brazil",
-	"12940,State of Sao Paulo,Brazil",
-	"12941,State of Sao Paulo,Brazil",
-	"12942,State of Sao Paulo,Brazil",
-	"12944,State of Sao Paulo,Brazil",
-	"12945,State of Sao Paulo,Brazil",
-	"12946,State of Sao Paulo,Brazil",
-	"12947,State of Sao Paulo,Brazil",
-	"12948,State of Sao Paulo,Brazil",
-	"12949,State of Sao Paulo,Brazil",
-	"12950,State of Sao Paulo,Brazil",
-	"12951,State of Sao Paulo,Brazil",
-	"12952,State of Sao Paulo,Brazil",
-	"12953,State of Sao Paulo,Brazil",
-	"12954,State of Sao Paulo,Brazil",
-	"13030,State of Sao Paulo,Brazil",
-	"13033,State of Sao Paulo,Brazil",
-	"13034,State of Sao Paulo,Brazil",
-	"13040,State of Sao Paulo,Brazil",
-	"13042,State of Sao Paulo,Brazil",
-	"13044,State of Sao Paulo,Brazil",
-	"13050,State of Sao Paulo,Brazil",
-	"13051,State of Sao Paulo,Brazil",
-	"13052,State of Sao Paulo,Brazil",
-	"13053,State of Sao Paulo,Brazil",
-	"13054,State of Sao Paulo,Brazil",
-	"13055,State of Sao Paulo,Brazil",
-	"13056,State of Sao Paulo,Brazil",
-	"13058,State of Sao Paulo,Brazil",
-	"13059,State of Sao Paulo,Brazil",
-	"13060,State of Sao Paulo,Brazil",
-	"13061,State of Sao Paulo,Brazil",
-	"13063,State of Sao Paulo,Brazil",
-	"13065,State of Sao Paulo,Brazil",
-	"13067,State of Sao Paulo,Brazil",
-	"13068,State of Sao Paulo,Brazil",
-	"13070,State of Sao Paulo,Brazil",
-	"13073,State of Sao Paulo,Brazil",
-	"13081,State of Sao Paulo,Brazil",
-	"13082,State of Sao Paulo,Brazil",
-	"13086,State of Sao Paulo,Brazil",
-	"13087,State of Sao Paulo,Brazil",
-	"13088,State of Sao Paulo,Brazil",
-	"13091,State of Sao Paulo,Brazil",
-	"13092,State of Sao Paulo,Brazil",
-	"13097,State of Sao Paulo,Brazil",
-	"13098,State of Sao Paulo,Brazil",
-	"13100,State of Sao Paulo,Brazil",
-	"13108,State of Sao Paulo,Brazil",
-	"13170,State of Sao Paulo,Brazil",
-	"13171,State of Sao Paulo,Brazil",
-	"13172,State of Sao Paulo,Brazil",
-	"13173,State of Sao Paulo,Brazil",
-	"13174,State of Sao Paulo,Brazil",
-	"13175,State of Sao Paulo,Brazil",
-	"13176,State of Sao Paulo,Brazil",
-	"13177,State of Sao Paulo,Brazil",
-	"13178,State of Sao Paulo,Brazil",
-	"13179,State of Sao Paulo,Brazil",
-	"13180,State of Sao Paulo,Brazil",
-	"13181,State of Sao Paulo,Brazil",
-	"13183,State of Sao Paulo,Brazil",
-	"13184,State of Sao Paulo,Brazil",
-	"13185,State of Sao Paulo,Brazil",
-	"13186,State of Sao Paulo,Brazil",
-	"13187,State of Sao Paulo,Brazil",
-	"13188,State of Sao Paulo,Brazil",
-	"13189,State of Sao Paulo,Brazil",
-	"13201,State of Sao Paulo,Brazil",
-	"13202,State of Sao Paulo,Brazil",
-	"13203,State of Sao Paulo,Brazil",
-	"13205,State of Sao Paulo,Brazil",
-	"13208,State of Sao Paulo,Brazil",
-	"13209,State of Sao Paulo,Brazil",
-	"13211,State of Sao Paulo,Brazil",
-	"13213,State of Sao Paulo,Brazil",
-	"13214,State of Sao Paulo,Brazil",
-	"13215,State of Sao Paulo,Brazil",
-	"13216,State of Sao Paulo,Brazil",
-	"13218,State of Sao Paulo,Brazil",
-	"13219,State of Sao Paulo,Brazil",
-	"13220,State of Sao Paulo,Brazil",
-	"13222,State of Sao Paulo,Brazil",
-	"13223,State of Sao Paulo,Brazil",
-	"13226,State of Sao Paulo,Brazil",
-	"13230,State of Sao Paulo,Brazil",
-	"13231,State of Sao Paulo,Brazil",
-	"13232,State of Sao Paulo,Brazil",
-	"13233,State of Sao Paulo,Brazil",
-	"13234,State of Sao Paulo,Brazil",
-	"13238,State of Sao Paulo,Brazil",
-	"13250,State of Sao Paulo,Brazil",
-	"13251,State of Sao Paulo,Brazil",
-	"13252,State of Sao Paulo,Brazil",
-	"13253,State of Sao Paulo,Brazil",
-	"13254,State of Sao Paulo,Brazil",
-	"13255,State of Sao Paulo,Brazil",
-	"13256,State of Sao Paulo,Brazil",
-	"13257,State of Sao Paulo,Brazil",
-	"13271,State of Sao Paulo,Brazil",
-	"13272,State of Sao Paulo,Brazil",
-	"13273,State of Sao Paulo,Brazil",
-	"13274,State of Sao Paulo,Brazil",
-	"13275,State of Sao Paulo,Brazil",
-	"13276,State of Sao Paulo,Brazil",
-	"13277,State of Sao Paulo,Brazil",
-	"13278,State of Sao Paulo,Brazil",
-	"13279,State of Sao Paulo,Brazil",
-	"13301,State of Sao Paulo,Brazil",
-	"13304,State of Sao Paulo,Brazil",
-	"13305,State of Sao Paulo,Brazil",
-	"13306,State of Sao Paulo,Brazil",
-	"13308,State of Sao Paulo,Brazil",
-	"13309,State of Sao Paulo,Brazil",
-	"13311,State of Sao Paulo,Brazil",
-	"13312,State of Sao Paulo,Brazil",
-	"13313,State of Sao Paulo,Brazil",
-	"13320,State of Sao Paulo,Brazil",
-	"13321,State of Sao Paulo,Brazil",
-	"13322,State of Sao Paulo,Brazil",
-	"13323,State of Sao Paulo,Brazil",
-	"13326,State of Sao Paulo,Brazil",
-	"13327,State of Sao Paulo,Brazil",
-	"13328,State of Sao Paulo,Brazil",
-	"13329,State of Sao Paulo,Brazil",
-	"13330,State of Sao Paulo,Brazil",
-	"13331,State of Sao Paulo,Brazil",
-	"13332,State of Sao Paulo,Brazil",
-	"13333,State of Sao Paulo,Brazil",
-	"13335,State of Sao Paulo,Brazil",
-	"13336,State of Sao Paulo,Brazil",
-	"13337,State of Sao Paulo,Brazil",
-	"13338,State of Sao Paulo,Brazil",
-	"13339,State of Sao Paulo,Brazil",
-	"13341,State of Sao Paulo,Brazil",
-	"13342,State of Sao Paulo,Brazil",
-	"13343,State of Sao Paulo,Brazil",
-	"13345,State of Sao Paulo,Brazil",
-	"13346,State of Sao Paulo,Brazil",
-	"13347,State of Sao Paulo,Brazil",
-	"13348,State of Sao Paulo,Brazil",
-	"13349,State of Sao Paulo,Brazil",
-	"13400,State of Sao Paulo,Brazil",
-	"13401,State of Sao Paulo,Brazil",
-	"13402,State of Sao Paulo,Brazil",
-	"13403,State of Sao Paulo,Brazil",
-	"13405,State of Sao Paulo,Brazil",
-	"13406,State of Sao Paulo,Brazil",
-	"13408,State of Sao Paulo,Brazil",
-	"13409,State of Sao Paulo,Brazil",
-	"13410,State of Sao Paulo,Brazil",
-	"13412,State of Sao Paulo,Brazil",
-	"13413,State of Sao Paulo,Brazil",
-	"13416,State of Sao Paulo,Brazil",
-	"13417,State of Sao Paulo,Brazil",
-	"13418,State of Sao Paulo,Brazil",
-	"13420,State of Sao Paulo,Brazil",
-	"13421,State of Sao Paulo,Brazil",
-	"13422,State of Sao Paulo,Brazil",
-	"13423,State of Sao Paulo,Brazil",
-	"13424,State of Sao Paulo,Brazil",
-	"13425,State of Sao Paulo,Brazil",
-	"13426,State of Sao Paulo,Brazil",
-	"13432,State of Sao Paulo,Brazil",
-	"13450,State of Sao Paulo,Brazil",
-	"13452,State of Sao Paulo,Brazil",
-	"13453,State of Sao Paulo,Brazil",
-	"13454,State of Sao Paulo,Brazil",
-	"13455,State of Sao Paulo,Brazil",
-	"13456,State of Sao Paulo,Brazil",
-	"13457,State of Sao Paulo,Brazil",
-	"13458,State of Sao Paulo,Brazil",
-	"13459,State of Sao Paulo,Brazil",
-	"13467,State of Sao Paulo,Brazil",
-	"13468,State of Sao Paulo,Brazil",
-	"13470,State of Sao Paulo,Brazil",
-	"13471,State of Sao Paulo,Brazil",
-	"13472,State of Sao Paulo,Brazil",
-	"13474,State of Sao Paulo,Brazil",
-	"13475,State of Sao Paulo,Brazil",
-	"13476,State of Sao Paulo,Brazil",
-	"13477,State of Sao Paulo,Brazil",
-	"13480,State of Sao Paulo,Brazil",
-	"13481,State of Sao Paulo,Brazil",
-	"13482,State of Sao Paulo,Brazil",
-	"13483,State of Sao Paulo,Brazil",
-	"13485,State of Sao Paulo,Brazil",
-	"13487,State of Sao Paulo,Brazil",
-	"13500,State of Sao Paulo,Brazil",
-	"13501,State of Sao Paulo,Brazil",
-	"13502,State of Sao Paulo,Brazil",
-	"13503,State of Sao Paulo,Brazil",
-	"13504,State of Sao Paulo,Brazil",
-	"13505,State of Sao Paulo,Brazil",
-	"13506,State of Sao Paulo,Brazil",
-	"13560,State of Sao Paulo,Brazil",
-	"13561,State of Sao Paulo,Brazil",
-	"13562,State of Sao Paulo,Brazil",
-	"13563,State of Sao Paulo,Brazil",
-	"13564,State of Sao Paulo,Brazil",
-	"13565,State of Sao Paulo,Brazil",
-	"13566,State of Sao Paulo,Brazil",
-	"13570,State of Sao Paulo,Brazil",
-	"13572,State of Sao Paulo,Brazil",
-	"13573,State of Sao Paulo,Brazil",
-	"13575,State of Sao Paulo,Brazil",
-	"13600,State of Sao Paulo,Brazil",
-	"13602,State of Sao Paulo,Brazil",
-	"13603,State of Sao Paulo,Brazil",
-	"13604,State of Sao Paulo,Brazil",
-	"13605,State of Sao Paulo,Brazil",
-	"13606,State of Sao Paulo,Brazil",
-	"13607,State of Sao Paulo,Brazil",
-	"13609,State of Sao Paulo,Brazil",
-	"13610,State of Sao Paulo,Brazil",
-	"13611,State of Sao Paulo,Brazil",
-	"13612,State of Sao Paulo,Brazil",
-	"13613,State of Sao Paulo,Brazil",
-	"13614,State of Sao Paulo,Brazil",
-	"13615,State of Sao Paulo,Brazil",
-	"13616,State of Sao Paulo,Brazil",
-	"13617,State of Sao Paulo,Brazil",
-	"13630,State of Sao Paulo,Brazil",
-	"13631,State of Sao Paulo,Brazil",
-	"13632,State of Sao Paulo,Brazil",
-	"13633,State of Sao Paulo,Brazil",
-	"13634,State of Sao Paulo,Brazil",
-	"13635,State of Sao Paulo,Brazil",
-	"13636,State of Sao Paulo,Brazil",
-	"13637,State of Sao Paulo,Brazil",
-	"13640,State of Sao Paulo,Brazil",
-	"13641,State of Sao Paulo,Brazil",
-	"13643,State of Sao Paulo,Brazil",
-	"13645,State of Sao Paulo,Brazil",
-	"13731,State of Sao Paulo,Brazil",
-	"13841,State of Sao Paulo,Brazil",
-	"13842,State of Sao Paulo,Brazil",
-	"13843,State of Sao Paulo,Brazil",
-	"13844,State of Sao Paulo,Brazil",
-	"13845,State of Sao Paulo,Brazil",
-	"13846,State of Sao Paulo,Brazil",
-	"13848,State of Sao Paulo,Brazil",
-	"13849,State of Sao Paulo,Brazil",
-	"13870,State of Sao Paulo,Brazil",
-	"13871,State of Sao Paulo,Brazil",
-	"13872,State of Sao Paulo,Brazil",
-	"13873,State of Sao Paulo,Brazil",
-	"13874,State of Sao Paulo,Brazil",
-	"13875,State of Sao Paulo,Brazil",
-	"13876,State of Sao Paulo,Brazil",
-	"13877,State of Sao Paulo,Brazil",
-	"13900,State of Sao Paulo,Brazil",
-	"13901,State of Sao Paulo,Brazil",
-	"13902,State of Sao Paulo,Brazil",
-	"13903,State of Sao Paulo,Brazil",
-	"13904,State of Sao Paulo,Brazil",
-	"13905,State of Sao Paulo,Brazil",
-	"13908,State of Sao Paulo,Brazil",
-	"13909,State of Sao Paulo,Brazil",
-	"13971,State of Sao Paulo,Brazil",
-	"13972,State of Sao Paulo,Brazil",
-	"13973,State of Sao Paulo,Brazil",
-	"13974,State of Sao Paulo,Brazil",
-	"13975,State of Sao Paulo,Brazil",
-	"13976,State of Sao Paulo,Brazil",
-	"14020,State of Sao Paulo,Brazil",
-	"14024,State of Sao Paulo,Brazil",
-	"14026,State of Sao Paulo,Brazil",
-	"14030,State of Sao Paulo,Brazil",
-	"14031,State of Sao Paulo,Brazil",
-	"14033,State of Sao Paulo,Brazil",
-	"14040,State of Sao Paulo,Brazil",
-	"14050,State of Sao Paulo,Brazil",
-	"14051,State of Sao Paulo,Brazil",
-	"14055,State of Sao Paulo,Brazil",
-	"14060,State of Sao Paulo,Brazil",
-	"14075,State of Sao Paulo,Brazil",
-	"14076,State of Sao Paulo,Brazil",
-	"14078,State of Sao Paulo,Brazil",
-	"14079,State of Sao Paulo,Brazil",
-	"14080,State of Sao Paulo,Brazil",
-	"14091,State of Sao Paulo,Brazil",
-	"14092,State of Sao Paulo,Brazil",
-	"14094,State of Sao Paulo,Brazil",
-	"14095,State of Sao Paulo,Brazil",
-	"14097,State of Sao Paulo,Brazil",
-	"14098,State of Sao Paulo,Brazil",
-	"14176,State of Sao Paulo,Brazil",
-	"14400,State of Sao Paulo,Brazil",
-	"14401,State of Sao Paulo,Brazil",
-	"14402,State of Sao Paulo,Brazil",
-	"14403,State of Sao Paulo,Brazil",
-	"14404,State of Sao Paulo,Brazil",
-	"14405,State of Sao Paulo,Brazil",
-	"14406,State of Sao Paulo,Brazil",
-	"14407,State of Sao Paulo,Brazil",
-	"14409,State of Sao Paulo,Brazil",
-	"14412,State of Sao Paulo,Brazil",
-	"14706,State of Sao Paulo,Brazil",
-	"14709,State of Sao Paulo,Brazil",
-	"14711,State of Sao Paulo,Brazil",
-	"14780,State of Sao Paulo,Brazil",
-	"14781,State of Sao Paulo,Brazil",
-	"14783,State of Sao Paulo,Brazil",
-	"14784,State of Sao Paulo,Brazil",
-	"14786,State of Sao Paulo,Brazil",
-	"14787,State of Sao Paulo,Brazil",
-	"14800,State of Sao Paulo,Brazil",
-	"14801,State of Sao Paulo,Brazil",
-	"14802,State of Sao Paulo,Brazil",
-	"14803,State of Sao Paulo,Brazil",
-	"14804,State of Sao Paulo,Brazil",
-	"14805,State of Sao Paulo,Brazil",
-	"14806,State of Sao Paulo,Brazil",
-	"14807,State of Sao Paulo,Brazil",
-	"14808,State of Sao Paulo,Brazil",
-	"14810,State of Sao Paulo,Brazil",
-	"14811,State of Sao Paulo,Brazil",
-	"14875,State of Sao Paulo,Brazil",
-	"14876,State of Sao Paulo,Brazil",
-	"15040,State of Sao Paulo,Brazil",
-	"15041,State of Sao Paulo,Brazil",
-	"15044,State of Sao Paulo,Brazil",
-	"15045,State of Sao Paulo,Brazil",
-	"15046,State of Sao Paulo,Brazil",
-	"15047,State of Sao Paulo,Brazil",
-	"15052,State of Sao Paulo,Brazil",
-	"15053,State of Sao Paulo,Brazil",
-	"15056,State of Sao Paulo,Brazil",
-	"15057,State of Sao Paulo,Brazil",
-	"15061,State of Sao Paulo,Brazil",
-	"15063,State of Sao Paulo,Brazil",
-	"15077,State of Sao Paulo,Brazil",
-	"15081,State of Sao Paulo,Brazil",
-	"15082,State of Sao Paulo,Brazil",
-	"15087,State of Sao Paulo,Brazil",
-	"15092,State of Sao Paulo,Brazil",
-	"15093,State of Sao Paulo,Brazil",
-	"15104,State of Sao Paulo,Brazil",
-	"15500,State of Sao Paulo,Brazil",
-	"15501,State of Sao Paulo,Brazil",
-	"15503,State of Sao Paulo,Brazil",
-	"15506,State of Sao Paulo,Brazil",
-	"15700,State of Sao Paulo,Brazil",
-	"15703,State of Sao Paulo,Brazil",
-	"15704,State of Sao Paulo,Brazil",
-	"15706,State of Sao Paulo,Brazil",
-	"15800,State of Sao Paulo,Brazil",
-	"15803,State of Sao Paulo,Brazil",
-	"15804,State of Sao Paulo,Brazil",
-	"15806,State of Sao Paulo,Brazil",
-	"15807,State of Sao Paulo,Brazil",
-	"15808,State of Sao Paulo,Brazil",
-	"15809,State of Sao Paulo,Brazil",
-	"15810,State of Sao Paulo,Brazil",
-	"15816,State of Sao Paulo,Brazil",
-	"15990,State of Sao Paulo,Brazil",
-	"15991,State of Sao Paulo,Brazil",
-	"15995,State of Sao Paulo,Brazil",
-	"15997,State of Sao Paulo,Brazil",
-	"16012,State of Sao Paulo,Brazil",
-	"16018,State of Sao Paulo,Brazil",
-	"16026,State of Sao Paulo,Brazil",
-	"16056,State of Sao Paulo,Brazil",
-	"16078,State of Sao Paulo,Brazil",
-	"16200,State of Sao Paulo,Brazil",
-	"16201,State of Sao Paulo,Brazil",
-	"16202,State of Sao Paulo,Brazil",
-	"16206,State of Sao Paulo,Brazil",
-	"16208,State of Sao Paulo,Brazil",
-	"16400,State of Sao Paulo,Brazil",
-	"16402,State of Sao Paulo,Brazil",
-	"16403,State of Sao Paulo,Brazil",
-	"16901,State of Sao Paulo,Brazil",
-	"16902,State of Sao Paulo,Brazil",
-	"16903,State of Sao Paulo,Brazil",
-	"17010,State of Sao Paulo,Brazil",
-	"17014,State of Sao Paulo,Brazil",
-	"17018,State of Sao Paulo,Brazil",
-	"17021,State of Sao Paulo,Brazil",
-	"17022,State of Sao Paulo,Brazil",
-	"17023,State of Sao Paulo,Brazil",
-	"17026,State of Sao Paulo,Brazil",
-	"17030,State of Sao Paulo,Brazil",
-	"17033,State of Sao Paulo,Brazil",
-	"17034,State of Sao Paulo,Brazil",
-	"17047,State of Sao Paulo,Brazil",
-	"17054,State of Sao Paulo,Brazil",
-	"17057,State of Sao Paulo,Brazil",
-	"17203,State of Sao Paulo,Brazil",
-	"17204,State of Sao Paulo,Brazil",
-	"17207,State of Sao Paulo,Brazil",
-	"17210,State of Sao Paulo,Brazil",
-	"17211,State of Sao Paulo,Brazil",
-	"17511,State of Sao Paulo,Brazil",
-	"17512,State of Sao Paulo,Brazil",
-	"17520,State of Sao Paulo,Brazil",
-	"17523,State of Sao Paulo,Brazil",
-	"17602,State of Sao Paulo,Brazil",
-	"17603,State of Sao Paulo,Brazil",
-	"17605,State of Sao Paulo,Brazil",
-	"18017,State of Sao Paulo,Brazil",
-	"18021,State of Sao Paulo,Brazil",
-	"18050,State of Sao Paulo,Brazil",
-	"18054,State of Sao Paulo,Brazil",
-	"18057,State of Sao Paulo,Brazil",
-	"18071,State of Sao Paulo,Brazil",
-	"18072,State of Sao Paulo,Brazil",
-	"18074,State of Sao Paulo,Brazil",
-	"18077,State of Sao Paulo,Brazil",
-	"18078,State of Sao Paulo,Brazil",
-	"18081,State of Sao Paulo,Brazil",
-	"18085,State of Sao Paulo,Brazil",
-	"18086,State of Sao Paulo,Brazil",
-	"18087,State of Sao Paulo,Brazil",
-	"18103,State of Sao Paulo,Brazil",
-	"18130,State of Sao Paulo,Brazil",
-	"18131,State of Sao Paulo,Brazil",
-	"18135,State of Sao Paulo,Brazil",
-	"18200,State of Sao Paulo,Brazil",
-	"18202,State of Sao Paulo,Brazil",
-	"18203,State of Sao Paulo,Brazil",
-	"18204,State of Sao Paulo,Brazil",
-	"18205,State of Sao Paulo,Brazil",
-	"18206,State of Sao Paulo,Brazil",
-	"18207,State of Sao Paulo,Brazil",
-	"18210,State of Sao Paulo,Brazil",
-	"18212,State of Sao Paulo,Brazil",
-	"18213,State of Sao Paulo,Brazil",
-	"18270,State of Sao Paulo,Brazil",
-	"18271,State of Sao Paulo,Brazil",
-	"18272,State of Sao Paulo,Brazil",
-	"18276,State of Sao Paulo,Brazil",
-	"18278,State of Sao Paulo,Brazil",
-	"18279,State of Sao Paulo,Brazil",
-	"18307,State of Sao Paulo,Brazil",
-	"18403,State of Sao Paulo,Brazil",
-	"18407,State of Sao Paulo,Brazil",
-	"18602,State of Sao Paulo,Brazil",
-	"18605,State of Sao Paulo,Brazil",
-	"18606,State of Sao Paulo,Brazil",
-	"18607,State of Sao Paulo,Brazil",
-	"18608,State of Sao Paulo,Brazil",
-	"18610,State of Sao Paulo,Brazil",
-	"18618,State of Sao Paulo,Brazil",
-	"18682,State of Sao Paulo,Brazil",
-	"18683,State of Sao Paulo,Brazil",
-	"18701,State of Sao Paulo,Brazil",
-	"18702,State of Sao Paulo,Brazil",
-	"18705,State of Sao Paulo,Brazil",
-	"18707,State of Sao Paulo,Brazil",
-	"18708,State of Sao Paulo,Brazil",
-	"19030,State of Sao Paulo,Brazil",
-	"19034,State of Sao Paulo,Brazil",
-	"19035,State of Sao Paulo,Brazil",
-	"19042,State of Sao Paulo,Brazil",
-	"19043,State of Sao Paulo,Brazil",
-	"19051,State of Sao Paulo,Brazil",
-	"19053,State of Sao Paulo,Brazil",
-	"19057,State of Sao Paulo,Brazil",
-	"19063,State of Sao Paulo,Brazil",
-	"19064,State of Sao Paulo,Brazil",
-	"19100,State of Sao Paulo,Brazil",
-	"19807,State of Sao Paulo,Brazil",
-	"19813,State of Sao Paulo,Brazil",
-	"19904,State of Sao Paulo,Brazil",
-	"19905,State of Sao Paulo,Brazil",
-	"19909,State of Sao Paulo,Brazil",
-	"19910,State of Sao Paulo,Brazil",
-	"19911,State of Sao Paulo,Brazil",
-	"20521,State of Rio de Janeiro,Brazil",
-	"20530,State of Rio de Janeiro,Brazil",
-	"20531,State of Rio de Janeiro,Brazil",
-	"20535,State of Rio de Janeiro,Brazil",
-	"20561,State of Rio de Janeiro,Brazil",
-	"20715,State of Rio de Janeiro,Brazil",
-	"20720,State of Rio de Janeiro,Brazil",
-	"20730,State of Rio de Janeiro,Brazil",
-	"20745,State of Rio de Janeiro,Brazil",
-	"21311,State of Rio de Janeiro,Brazil",
-	"21320,State of Rio de Janeiro,Brazil",
-	"21330,State of Rio de Janeiro,Brazil",
-	"21615,State of Rio de Janeiro,Brazil",
-	"21616,State of Rio de Janeiro,Brazil",
-	"21635,State of Rio de Janeiro,Brazil",
-	"21725,State of Rio de Janeiro,Brazil",
-	"21730,State of Rio de Janeiro,Brazil",
-	"21740,State of Rio de Janeiro,Brazil",
-	"21760,State of Rio de Janeiro,Brazil",
-	"21770,State of Rio de Janeiro,Brazil",
-	"21775,State of Rio de Janeiro,Brazil",
-	"21825,State of Rio de Janeiro,Brazil",
-	"21831,State of Rio de Janeiro,Brazil",
-	"21832,State of Rio de Janeiro,Brazil",
-	"21853,State of Rio de Janeiro,Brazil",
-	"21932,State of Rio de Janeiro,Brazil",
-	"21941,State of Rio de Janeiro,Brazil",
-	"22451,State of Rio de Janeiro,Brazil",
-	"22460,State of Rio de Janeiro,Brazil",
-	"22610,State of Rio de Janeiro,Brazil",
-	"22611,State of Rio de Janeiro,Brazil",
-	"22630,State of Rio de Janeiro,Brazil",
-	"22641,State of Rio de Janeiro,Brazil",
-	"22711,State of Rio de Janeiro,Brazil",
-	"22723,State of Rio de Janeiro,Brazil",
-	"22733,State of Rio de Janeiro,Brazil",
-	"22735,State of Rio de Janeiro,Brazil",
-	"22745,State of Rio de Janeiro,Brazil",
-	"22750,State of Rio de Janeiro,Brazil",
-	"22753,State of Rio de Janeiro,Brazil",
-	"22755,State of Rio de Janeiro,Brazil",
-	"22765,State of Rio de Janeiro,Brazil",
-	"22775,State of Rio de Janeiro,Brazil",
-	"22780,State of Rio de Janeiro,Brazil",
-	"22785,State of Rio de Janeiro,Brazil",
-	"22790,State of Rio de Janeiro,Brazil",
-	"22793,State of Rio de Janeiro,Brazil",
-	"22795,State of Rio de Janeiro,Brazil",
-	"23012,State of Rio de Janeiro,Brazil",
-	"23017,State of Rio de Janeiro,Brazil",
-	"23020,State of Rio de Janeiro,Brazil",
-	"23025,State of Rio de Janeiro,Brazil",
-	"23026,State of Rio de Janeiro,Brazil",
-	"23028,State of Rio de Janeiro,Brazil",
-	"23030,State of Rio de Janeiro,Brazil",
-	"23031,State of Rio de Janeiro,Brazil",
-	"23032,State of Rio de Janeiro,Brazil",
-	"23033,State of Rio de Janeiro,Brazil",
-	"23035,State of Rio de Janeiro,Brazil",
-	"23036,State of Rio de Janeiro,Brazil",
-	"23040,State of Rio de Janeiro,Brazil",
-	"23042,State of Rio de Janeiro,Brazil",
-	"23056,State of Rio de Janeiro,Brazil",
-	"23065,State of Rio de Janeiro,Brazil",
-	"23067,State of Rio de Janeiro,Brazil",
-	"23069,State of Rio de Janeiro,Brazil",
-	"23075,State of Rio de Janeiro,Brazil",
-	"23092,State of Rio de Janeiro,Brazil",
-	"23095,State of Rio de Janeiro,Brazil",
-	"23097,State of Rio de Janeiro,Brazil",
-	"23098,State of Rio de Janeiro,Brazil",
-	"23525,State of Rio de Janeiro,Brazil",
-	"23547,State of Rio de Janeiro,Brazil",
-	"23550,State of Rio de Janeiro,Brazil",
-	"23555,State of Rio de Janeiro,Brazil",
-	"23560,State of Rio de Janeiro,Brazil",
-	"23565,State of Rio de Janeiro,Brazil",
-	"23573,State of Rio de Janeiro,Brazil",
-	"23575,State of Rio de Janeiro,Brazil",
-	"23580,State of Rio de Janeiro,Brazil",
-	"23585,State of Rio de Janeiro,Brazil",
-	"23590,State of Rio de Janeiro,Brazil",
-	"23591,State of Rio de Janeiro,Brazil",
-	"23595,State of Rio de Janeiro,Brazil",
-	"23819,State of Rio de Janeiro,Brazil",
-	"23825,State of Rio de Janeiro,Brazil",
-	"23826,State of Rio de Janeiro,Brazil",
-	"23914,State of Rio de Janeiro,Brazil",
-	"23932,State of Rio de Janeiro,Brazil",
-	"24130,State of Rio de Janeiro,Brazil",
-	"24140,State of Rio de Janeiro,Brazil",
-	"24141,State of Rio de Janeiro,Brazil",
-	"24310,State of Rio de Janeiro,Brazil",
-	"24315,State of Rio de Janeiro,Brazil",
-	"24320,State of Rio de Janeiro,Brazil",
-	"24322,State of Rio de Janeiro,Brazil",
-	"24330,State of Rio de Janeiro,Brazil",
-	"24340,State of Rio de Janeiro,Brazil",
-	"24342,State of Rio de Janeiro,Brazil",
-	"24348,State of Rio de Janeiro,Brazil",
-	"24355,State of Rio de Janeiro,Brazil",
-	"24358,State of Rio de Janeiro,Brazil",
-	"24410,State of Rio de Janeiro,Brazil",
-	"24411,State of Rio de Janeiro,Brazil",
-	"24417,State of Rio de Janeiro,Brazil",
-	"24420,State of Rio de Janeiro,Brazil",
-	"24471,State of Rio de Janeiro,Brazil",
-	"24721,State of Rio de Janeiro,Brazil",
-	"24722,State of Rio de Janeiro,Brazil",
-	"24723,State of Rio de Janeiro,Brazil",
-	"24724,State of Rio de Janeiro,Brazil",
-	"24726,State of Rio de Janeiro,Brazil",
-	"24735,State of Rio de Janeiro,Brazil",
-	"24737,State of Rio de Janeiro,Brazil",
-	"24738,State of Rio de Janeiro,Brazil",
-	"24746,State of Rio de Janeiro,Brazil",
-	"24750,State of Rio de Janeiro,Brazil",
-	"24752,State of Rio de Janeiro,Brazil",
-	"24753,State of Rio de Janeiro,Brazil",
-	"24756,State of Rio de Janeiro,Brazil",
-	"24800,State of Rio de Janeiro,Brazil",
-	"25040,State of Rio de Janeiro,Brazil",
-	"25081,State of Rio de Janeiro,Brazil",
-	"25213,State of Rio de Janeiro,Brazil",
-	"25214,State of Rio de Janeiro,Brazil",
-	"25220,State of Rio de Janeiro,Brazil",
-	"25221,State of Rio de Janeiro,Brazil",
-	"25223,State of Rio de Janeiro,Brazil",
-	"25225,State of Rio de Janeiro,Brazil",
-	"25230,State of Rio de Janeiro,Brazil",
-	"25231,State of Rio de Janeiro,Brazil",
-	"25233,State of Rio de Janeiro,Brazil",
-	"25235,State of Rio de Janeiro,Brazil",
-	"25240,State of Rio de Janeiro,Brazil",
-	"25243,State of Rio de Janeiro,Brazil",
-	"25245,State of Rio de Janeiro,Brazil",
-	"25250,State of Rio de Janeiro,Brazil",
-	"25251,State of Rio de Janeiro,Brazil",
-	"25253,State of Rio de Janeiro,Brazil",
-	"25255,State of Rio de Janeiro,Brazil",
-	"25260,State of Rio de Janeiro,Brazil",
-	"25265,State of Rio de Janeiro,Brazil",
-	"25270,State of Rio de Janeiro,Brazil",
-	"25271,State of Rio de Janeiro,Brazil",
-	"25610,State of Rio de Janeiro,Brazil",
-	"25615,State of Rio de Janeiro,Brazil",
-	"25630,State of Rio de Janeiro,Brazil",
-	"25645,State of Rio de Janeiro,Brazil",
-	"25650,State of Rio de Janeiro,Brazil",
-	"25660,State of Rio de Janeiro,Brazil",
-	"25665,State of Rio de Janeiro,Brazil",
-	"25675,State of Rio de Janeiro,Brazil",
-	"25710,State of Rio de Janeiro,Brazil",
-	"25715,State of Rio de Janeiro,Brazil",
-	"25716,State of Rio de Janeiro,Brazil",
-	"25720,State of Rio de Janeiro,Brazil",
-	"25725,State of Rio de Janeiro,Brazil",
-	"25730,State of Rio de Janeiro,Brazil",
-	"25740,State of Rio de Janeiro,Brazil",
-	"25745,State of Rio de Janeiro,Brazil",
-	"25750,State of Rio de Janeiro,Brazil",
-	"25809,State of Rio de Janeiro,Brazil",
-	"25811,State of Rio de Janeiro,Brazil",
-	"25820,State of Rio de Janeiro,Brazil",
-	"25821,State of Rio de Janeiro,Brazil",
-	"25963,State of Rio de Janeiro,Brazil",
-	"25975,State of Rio de Janeiro,Brazil",
-	"25978,State of Rio de Janeiro,Brazil",
-	"26032,State of Rio de Janeiro,Brazil",
-	"26040,State of Rio de Janeiro,Brazil",
-	"26043,State of Rio de Janeiro,Brazil",
-	"26050,State of Rio de Janeiro,Brazil",
-	"26052,State of Rio de Janeiro,Brazil",
-	"26053,State of Rio de Janeiro,Brazil",
-	"26055,State of Rio de Janeiro,Brazil",
-	"26062,State of Rio de Janeiro,Brazil",
-	"26063,State of Rio de Janeiro,Brazil",
-	"26064,State of Rio de Janeiro,Brazil",
-	"26065,State of Rio de Janeiro,Brazil",
-	"26080,State of Rio de Janeiro,Brazil",
-	"26082,State of Rio de Janeiro,Brazil",
-	"26087,State of Rio de Janeiro,Brazil",
-	"26089,State of Rio de Janeiro,Brazil",
-	"26092,State of Rio de Janeiro,Brazil",
-	"26099,State of Rio de Janeiro,Brazil",
-	"26155,State of Rio de Janeiro,Brazil",
-	"26163,State of Rio de Janeiro,Brazil",
-	"26255,State of Rio de Janeiro,Brazil",
-	"26261,State of Rio de Janeiro,Brazil",
-	"26265,State of Rio de Janeiro,Brazil",
-	"26270,State of Rio de Janeiro,Brazil",
-	"26290,State of Rio de Janeiro,Brazil",
-	"26291,State of Rio de Janeiro,Brazil",
-	"26292,State of Rio de Janeiro,Brazil",
-	"26293,State of Rio de Janeiro,Brazil",
-	"26294,State of Rio de Janeiro,Brazil",
-	"26295,State of Rio de Janeiro,Brazil",
-	"26296,State of Rio de Janeiro,Brazil",
-	"26298,State of Rio de Janeiro,Brazil",
-	"26299,State of Rio de Janeiro,Brazil",
-	"26330,State of Rio de Janeiro,Brazil",
-	"26375,State of Rio de Janeiro,Brazil",
-	"26377,State of Rio de Janeiro,Brazil",
-	"26393,State of Rio de Janeiro,Brazil",
-	"26420,State of Rio de Janeiro,Brazil",
-	"26433,State of Rio de Janeiro,Brazil",
-	"26440,State of Rio de Janeiro,Brazil",
-	"26450,State of Rio de Janeiro,Brazil",
-	"26453,State of Rio de Janeiro,Brazil",
-	"26480,State of Rio de Janeiro,Brazil",
-	"26520,State of Rio de Janeiro,Brazil",
-	"26554,State of Rio de Janeiro,Brazil",
-	"26585,State of Rio de Janeiro,Brazil",
-	"27143,State of Rio de Janeiro,Brazil",
-	"27220,State of Rio de Janeiro,Brazil",
-	"27325,State of Rio de Janeiro,Brazil",
-	"27336,State of Rio de Janeiro,Brazil",
-	"27521,State of Rio de Janeiro,Brazil",
-	"27525,State of Rio de Janeiro,Brazil",
-	"27532,State of Rio de Janeiro,Brazil",
-	"27533,State of Rio de Janeiro,Brazil",
-	"27537,State of Rio de Janeiro,Brazil",
-	"27540,State of Rio de Janeiro,Brazil",
-	"27925,State of Rio de Janeiro,Brazil",
-	"27930,State of Rio de Janeiro,Brazil",
-	"27947,State of Rio de Janeiro,Brazil",
-	"27971,State of Rio de Janeiro,Brazil",
-	"28015,State of Rio de Janeiro,Brazil",
-	"28016,State of Rio de Janeiro,Brazil",
-	"28021,State of Rio de Janeiro,Brazil",
-	"28055,State of Rio de Janeiro,Brazil",
-	"28083,State of Rio de Janeiro,Brazil",
-	"28090,State of Rio de Janeiro,Brazil",
-	"28200,State of Rio de Janeiro,Brazil",
-	"28610,State of Rio de Janeiro,Brazil",
-	"28614,State of Rio de Janeiro,Brazil",
-	"28615,State of Rio de Janeiro,Brazil",
-	"28616,State of Rio de Janeiro,Brazil",
-	"28621,State of Rio de Janeiro,Brazil",
-	"28626,State of Rio de Janeiro,Brazil",
-	"28630,State of Rio de Janeiro,Brazil",
-	"28634,State of Rio de Janeiro,Brazil",
-	"28635,State of Rio de Janeiro,Brazil",
-	"28908,State of Rio de Janeiro,Brazil",
-	"28920,State of Rio de Janeiro,Brazil",
-	"28921,State of Rio de Janeiro,Brazil",
-	"28922,State of Rio de Janeiro,Brazil",
-	"28923,State of Rio de Janeiro,Brazil",
-	"28924,State of Rio de Janeiro,Brazil",
-	"29075,State of Espirito Santo,Brazil",
-	"29090,State of Espirito Santo,Brazil",
-	"29103,State of Espirito Santo,Brazil",
-	"29104,State of Espirito Santo,Brazil",
-	"29112,State of Espirito Santo,Brazil",
-	"29113,State of Espirito Santo,Brazil",
-	"29124,State of Espirito Santo,Brazil",
-	"29126,State of Espirito Santo,Brazil",
-	"29127,State of Espirito Santo,Brazil",
-	"29128,State of Espirito Santo,Brazil",
-	"29142,State of Espirito Santo,Brazil",
-	"29144,State of Espirito Santo,Brazil",
-	"29148,State of Espirito Santo,Brazil",
-	"29154,State of Espirito Santo,Brazil",
-	"29155,State of Espirito Santo,Brazil",
-	"29156,State of Espirito Santo,Brazil",
-	"29157,State of Espirito Santo,Brazil",
-	"29158,State of Espirito Santo,Brazil",
-	"29160,State of Espirito Santo,Brazil",
-	"29162,State of Espirito Santo,Brazil",
-	"29163,State of Espirito Santo,Brazil",
-	"29164,State of Espirito Santo,Brazil",
-	"29165,State of Espirito Santo,Brazil",
-	"29166,State of Espirito Santo,Brazil",
-	"29167,State of Espirito Santo,Brazil",
-	"29168,State of Espirito Santo,Brazil",
-	"29170,State of Espirito Santo,Brazil",
-	"29171,State of Espirito Santo,Brazil",
-	"29172,State of Espirito Santo,Brazil",
-	"29173,State of Espirito Santo,Brazil",
-	"29175,State of Espirito Santo,Brazil",
-	"29176,State of Espirito Santo,Brazil",
-	"29177,State of Espirito Santo,Brazil",
-	"29178,State of Espirito Santo,Brazil",
-	"29182,State of Espirito Santo,Brazil",
-	"29206,State of Espirito Santo,Brazil",
-	"29208,State of Espirito Santo,Brazil",
-	"29210,State of Espirito Santo,Brazil",
-	"29211,State of Espirito Santo,Brazil",
-	"29220,State of Espirito Santo,Brazil",
-	"29221,State of Espirito Santo,Brazil",
-	"29222,State of Espirito Santo,Brazil",
-	"29226,State of Espirito Santo,Brazil",
-	"29227,State of Espirito Santo,Brazil",
-	"29228,State of Espirito Santo,Brazil",
-	"29903,State of Espirito Santo,Brazil",
-	"29914,State of Espirito Santo,Brazil",
-	"29946,State of Espirito Santo,Brazil",
-	"29948,State of Espirito Santo,Brazil",
-	"31275,State of Minas Gerais,Brazil",
-	"31310,State of Minas Gerais,Brazil",
-	"31320,State of Minas Gerais,Brazil",
-	"31340,State of Minas Gerais,Brazil",
-	"31746,State of Minas Gerais,Brazil",
-	"32016,State of Minas Gerais,Brazil",
-	"32025,State of Minas Gerais,Brazil",
-	"32044,State of Minas Gerais,Brazil",
-	"32046,State of Minas Gerais,Brazil",
-	"32065,State of Minas Gerais,Brazil",
-	"32071,State of Minas Gerais,Brazil",
-	"32141,State of Minas Gerais,Brazil",
-	"32146,State of Minas Gerais,Brazil",
-	"32150,State of Minas Gerais,Brazil",
-	"32183,State of Minas Gerais,Brazil",
-	"32342,State of Minas Gerais,Brazil",
-	"32600,State of Minas Gerais,Brazil",
-	"32601,State of Minas Gerais,Brazil",
-	"32603,State of Minas Gerais,Brazil",
-	"32604,State of Minas Gerais,Brazil",
-	"32605,State of Minas Gerais,Brazil",
-	"32606,State of Minas Gerais,Brazil",
-	"32609,State of Minas Gerais,Brazil",
-	"32611,State of Minas Gerais,Brazil",
-	"32616,State of Minas Gerais,Brazil",
-	"32618,State of Minas Gerais,Brazil",
-	"32621,State of Minas Gerais,Brazil",
-	"32623,State of Minas Gerais,Brazil",
-	"32625,State of Minas Gerais,Brazil",
-	"32628,State of Minas Gerais,Brazil",
-	"32635,State of Minas Gerais,Brazil",
-	"32639,State of Minas Gerais,Brazil",
-	"32641,State of Minas Gerais,Brazil",
-	"32642,State of Minas Gerais,Brazil",
-	"32649,State of Minas Gerais,Brazil",
-	"32655,State of Minas Gerais,Brazil",
-	"32656,State of Minas Gerais,Brazil",
-	"32657,State of Minas Gerais,Brazil",
-	"32659,State of Minas Gerais,Brazil",
-	"32661,State of Minas Gerais,Brazil",
-	"32665,State of Minas Gerais,Brazil",
-	"32667,State of Minas Gerais,Brazil",
-	"32669,State of Minas Gerais,Brazil",
-	"32670,State of Minas Gerais,Brazil",
-	"32671,State of Minas Gerais,Brazil",
-	"32675,State of Minas Gerais,Brazil",
-	"32684,State of Minas Gerais,Brazil",
-	"32687,State of Minas Gerais,Brazil",
-	"33025,State of Minas Gerais,Brazil",
-	"33030,State of Minas Gerais,Brazil",
-	"33040,State of Minas Gerais,Brazil",
-	"33065,State of Minas Gerais,Brazil",
-	"33080,State of Minas Gerais,Brazil",
-	"33113,State of Minas Gerais,Brazil",
-	"33170,State of Minas Gerais,Brazil",
-	"33805,State of Minas Gerais,Brazil",
-	"33820,State of Minas Gerais,Brazil",
-	"33830,State of Minas Gerais,Brazil",
-	"33836,State of Minas Gerais,Brazil",
-	"33840,State of Minas Gerais,Brazil",
-	"33863,State of Minas Gerais,Brazil",
-	"33903,State of Minas Gerais,Brazil",
-	"33915,State of Minas Gerais,Brazil",
-	"33939,State of Minas Gerais,Brazil",
-	"34515,State of Minas Gerais,Brazil",
-	"34585,State of Minas Gerais,Brazil",
-	"34600,State of Minas Gerais,Brazil",
-	"35024,State of Minas Gerais,Brazil",
-	"35040,State of Minas Gerais,Brazil",
-	"35042,State of Minas Gerais,Brazil",
-	"35044,State of Minas Gerais,Brazil",
-	"35051,State of Minas Gerais,Brazil",
-	"35054,State of Minas Gerais,Brazil",
-	"35057,State of Minas Gerais,Brazil",
-	"35160,State of Minas Gerais,Brazil",
-	"35163,State of Minas Gerais,Brazil",
-	"35164,State of Minas Gerais,Brazil",
-	"35170,State of Minas Gerais,Brazil",
-	"35171,State of Minas Gerais,Brazil",
-	"35172,State of Minas Gerais,Brazil",
-	"35180,State of Minas Gerais,Brazil",
-	"35181,State of Minas Gerais,Brazil",
-	"35182,State of Minas Gerais,Brazil",
-	"35183,State of Minas Gerais,Brazil",
-	"35300,State of Minas Gerais,Brazil",
-	"35301,State of Minas Gerais,Brazil",
-	"35302,State of Minas Gerais,Brazil",
-	"35430,State of Minas Gerais,Brazil",
-	"35431,State of Minas Gerais,Brazil",
-	"35432,State of Minas Gerais,Brazil",
-	"35500,State of Minas Gerais,Brazil",
-	"35501,State of Minas Gerais,Brazil",
-	"35502,State of Minas Gerais,Brazil",
-	"35503,State of Minas Gerais,Brazil",
-	"35504,State of Minas Gerais,Brazil",
-	"35660,State of Minas Gerais,Brazil",
-	"35661,State of Minas Gerais,Brazil",
-	"35680,State of Minas Gerais,Brazil",
-	"35681,State of Minas Gerais,Brazil",
-	"35700,State of Minas Gerais,Brazil",
-	"35701,State of Minas Gerais,Brazil",
-	"35702,State of Minas Gerais,Brazil",
-	"35900,State of Minas Gerais,Brazil",
-	"35901,State of Minas Gerais,Brazil",
-	"35931,State of Minas Gerais,Brazil",
-	"36030,State of Minas Gerais,Brazil",
-	"36032,State of Minas Gerais,Brazil",
-	"36033,State of Minas Gerais,Brazil",
-	"36035,State of Minas Gerais,Brazil",
-	"36037,State of Minas Gerais,Brazil",
-	"36039,State of Minas Gerais,Brazil",
-	"36047,State of Minas Gerais,Brazil",
-	"36048,State of Minas Gerais,Brazil",
-	"36060,State of Minas Gerais,Brazil",
-	"36071,State of Minas Gerais,Brazil",
-	"36083,State of Minas Gerais,Brazil",
-	"36085,State of Minas Gerais,Brazil",
-	"36087,State of Minas Gerais,Brazil",
-	"36090,State of Minas Gerais,Brazil",
-	"36092,State of Minas Gerais,Brazil",
-	"36200,State of Minas Gerais,Brazil",
-	"36201,State of Minas Gerais,Brazil",
-	"36202,State of Minas Gerais,Brazil",
-	"36204,State of Minas Gerais,Brazil",
-	"36205,State of Minas Gerais,Brazil",
-	"36300,State of Minas Gerais,Brazil",
-	"36301,State of Minas Gerais,Brazil",
-	"36303,State of Minas Gerais,Brazil",
-	"36305,State of Minas Gerais,Brazil",
-	"36307,State of Minas Gerais,Brazil",
-	"36770,State of Minas Gerais,Brazil",
-	"36771,State of Minas Gerais,Brazil",
-	"36772,State of Minas Gerais,Brazil",
-	"36773,State of Minas Gerais,Brazil",
-	"36774,State of Minas Gerais,Brazil",
-	"37007,State of Minas Gerais,Brazil",
-	"37010,State of Minas Gerais,Brazil",
-	"37011,State of Minas Gerais,Brazil",
-	"37036,State of Minas Gerais,Brazil",
-	"37044,State of Minas Gerais,Brazil",
-	"37060,State of Minas Gerais,Brazil",
-	"37062,State of Minas Gerais,Brazil",
-	"37070,State of Minas Gerais,Brazil",
-	"37077,State of Minas Gerais,Brazil",
-	"37500,State of Minas Gerais,Brazil",
-	"37501,State of Minas Gerais,Brazil",
-	"37502,State of Minas Gerais,Brazil",
-	"37505,State of Minas Gerais,Brazil",
-	"37701,State of Minas Gerais,Brazil",
-	"37702,State of Minas Gerais,Brazil",
-	"37704,State of Minas Gerais,Brazil",
-	"37705,State of Minas Gerais,Brazil",
-	"37706,State of Minas Gerais,Brazil",
-	"37709,State of Minas Gerais,Brazil",
-	"37710,State of Minas Gerais,Brazil",
-	"37713,State of Minas Gerais,Brazil",
-	"37716,State of Minas Gerais,Brazil",
-	"37718,State of Minas Gerais,Brazil",
-	"37900,State of Minas Gerais,Brazil",
-	"37901,State of Minas Gerais,Brazil",
-	"37902,State of Minas Gerais,Brazil",
-	"37903,State of Minas Gerais,Brazil",
-	"38037,State of Minas Gerais,Brazil",
-	"38040,State of Minas Gerais,Brazil",
-	"38180,State of Minas Gerais,Brazil",
-	"38181,State of Minas Gerais,Brazil",
-	"38182,State of Minas Gerais,Brazil",
-	"38183,State of Minas Gerais,Brazil",
-	"38300,State of Minas Gerais,Brazil",
-	"38302,State of Minas Gerais,Brazil",
-	"38304,State of Minas Gerais,Brazil",
-	"38305,State of Minas Gerais,Brazil",
-	"38308,State of Minas Gerais,Brazil",
-	"38400,State of Minas Gerais,Brazil",
-	"38401,State of Minas Gerais,Brazil",
-	"38402,State of Minas Gerais,Brazil",
-	"38405,State of Minas Gerais,Brazil",
-	"38406,State of Minas Gerais,Brazil",
-	"38407,State of Minas Gerais,Brazil",
-	"38408,State of Minas Gerais,Brazil",
-	"38410,State of Minas Gerais,Brazil",
-	"38411,State of Minas Gerais,Brazil",
-	"38413,State of Minas Gerais,Brazil",
-	"38415,State of Minas Gerais,Brazil",
-	"38440,State of Minas Gerais,Brazil",
-	"38441,State of Minas Gerais,Brazil",
-	"38442,State of Minas Gerais,Brazil",
-	"38443,State of Minas Gerais,Brazil",
-	"38444,State of Minas Gerais,Brazil",
-	"38445,State of Minas Gerais,Brazil",
-	"38447,State of Minas Gerais,Brazil",
-	"38701,State of Minas Gerais,Brazil",
-	"38702,State of Minas Gerais,Brazil",
-	"38703,State of Minas Gerais,Brazil",
-	"38705,State of Minas Gerais,Brazil",
-	"38706,State of Minas Gerais,Brazil",
-	"39400,State of Minas Gerais,Brazil",
-	"39401,State of Minas Gerais,Brazil",
-	"39402,State of Minas Gerais,Brazil",
-	"39403,State of Minas Gerais,Brazil",
-	"39404,State of Minas Gerais,Brazil",
-	"39408,State of Minas Gerais,Brazil",
-	"39800,State of Minas Gerais,Brazil",
-	"39801,State of Minas Gerais,Brazil",
-	"39802,State of Minas Gerais,Brazil",
-	"39803,State of Minas Gerais,Brazil",
-	"40726,State of Bahia,Brazil",
-	"40800,State of Bahia,Brazil",
-	"41347,State of Bahia,Brazil",
-	"41349,State of Bahia,Brazil",
-	"41400,State of Bahia,Brazil",
-	"41410,State of Bahia,Brazil",
-	"41500,State of Bahia,Brazil",
-	"41502,State of Bahia,Brazil",
-	"41507,State of Bahia,Brazil",
-	"41600,State of Bahia,Brazil",
-	"41611,State of Bahia,Brazil",
-	"41650,State of Bahia,Brazil",
-	"41701,State of Bahia,Brazil",
-	"41730,State of Bahia,Brazil",
-	"42802,State of Bahia,Brazil",
-	"43815,State of Bahia,Brazil",
-	"44010,State of Bahia,Brazil",
-	"44013,State of Bahia,Brazil",
-	"44035,State of Bahia,Brazil",
-	"44046,State of Bahia,Brazil",
-	"44057,State of Bahia,Brazil",
-	"44059,State of Bahia,Brazil",
-	"44062,State of Bahia,Brazil",
-	"44069,State of Bahia,Brazil",
-	"44072,State of Bahia,Brazil",
-	"44079,State of Bahia,Brazil",
-	"44083,State of Bahia,Brazil",
-	"44086,State of Bahia,Brazil",
-	"44090,State of Bahia,Brazil",
-	"44091,State of Bahia,Brazil",
-	"44092,State of Bahia,Brazil",
-	"44094,State of Bahia,Brazil",
-	"44096,State of Bahia,Brazil",
-	"44097,State of Bahia,Brazil",
-	"44098,State of Bahia,Brazil",
-	"45003,State of Bahia,Brazil",
-	"45007,State of Bahia,Brazil",
-	"45012,State of Bahia,Brazil",
-	"45026,State of Bahia,Brazil",
-	"45027,State of Bahia,Brazil",
-	"45028,State of Bahia,Brazil",
-	"45029,State of Bahia,Brazil",
-	"45037,State of Bahia,Brazil",
-	"45038,State of Bahia,Brazil",
-	"45065,State of Bahia,Brazil",
-	"45066,State of Bahia,Brazil",
-	"45078,State of Bahia,Brazil",
-	"45202,State of Bahia,Brazil",
-	"45203,State of Bahia,Brazil",
-	"45205,State of Bahia,Brazil",
-	"45206,State of Bahia,Brazil",
-	"45207,State of Bahia,Brazil",
-	"45209,State of Bahia,Brazil",
-	"45600,State of Bahia,Brazil",
-	"45601,State of Bahia,Brazil",
-	"45603,State of Bahia,Brazil",
-	"45605,State of Bahia,Brazil",
-	"45608,State of Bahia,Brazil",
-	"45651,State of Bahia,Brazil",
-	"45820,State of Bahia,Brazil",
-	"45823,State of Bahia,Brazil",
-	"45825,State of Bahia,Brazil",
-	"45995,State of Bahia,Brazil",
-	"45998,State of Bahia,Brazil",
-	"47801,State of Bahia,Brazil",
-	"47802,State of Bahia,Brazil",
-	"47807,State of Bahia,Brazil",
-	"48020,State of Bahia,Brazil",
-	"48030,State of Bahia,Brazil",
-	"48060,State of Bahia,Brazil",
-	"48601,State of Bahia,Brazil",
-	"48604,State of Bahia,Brazil",
-	"48605,State of Bahia,Brazil",
-	"48609,State of Bahia,Brazil",
-	"48900,State of Bahia,Brazil",
-	"48901,State of Bahia,Brazil",
-	"48907,State of Bahia,Brazil",
-	"48909,State of Bahia,Brazil",
-	"48920,State of Bahia,Brazil",
-	"49032,State of Sergipe,Brazil",
-	"49036,State of Sergipe,Brazil",
-	"49037,State of Sergipe,Brazil",
-	"49038,State of Sergipe,Brazil",
-	"49039,State of Sergipe,Brazil",
-	"49040,State of Sergipe,Brazil",
-	"49043,State of Sergipe,Brazil",
-	"49044,State of Sergipe,Brazil",
-	"49061,State of Sergipe,Brazil",
-	"50980,State of Pernambuco,Brazil",
-	"51310,State of Pernambuco,Brazil",
-	"52170,State of Pernambuco,Brazil",
-	"52171,State of Pernambuco,Brazil",
-	"52291,State of Pernambuco,Brazil",
-	"52390,State of Pernambuco,Brazil",
-	"53405,State of Pernambuco,Brazil",
-	"53411,State of Pernambuco,Brazil",
-	"53425,State of Pernambuco,Brazil",
-	"53429,State of Pernambuco,Brazil",
-	"53439,State of Pernambuco,Brazil",
-	"53610,State of Pernambuco,Brazil",
-	"53615,State of Pernambuco,Brazil",
-	"53625,State of Pernambuco,Brazil",
-	"53647,State of Pernambuco,Brazil",
-	"53650,State of Pernambuco,Brazil",
-	"54090,State of Pernambuco,Brazil",
-	"54100,State of Pernambuco,Brazil",
-	"54130,State of Pernambuco,Brazil",
-	"54280,State of Pernambuco,Brazil",
-	"54300,State of Pernambuco,Brazil",
-	"54315,State of Pernambuco,Brazil",
-	"54340,State of Pernambuco,Brazil",
-	"54345,State of Pernambuco,Brazil",
-	"54360,State of Pernambuco,Brazil",
-	"54365,State of Pernambuco,Brazil",
-	"54368,State of Pernambuco,Brazil",
-	"54370,State of Pernambuco,Brazil",
-	"54515,State of Pernambuco,Brazil",
-	"54535,State of Pernambuco,Brazil",
-	"54590,State of Pernambuco,Brazil",
-	"54725,State of Pernambuco,Brazil",
-	"54745,State of Pernambuco,Brazil",
-	"54783,State of Pernambuco,Brazil",
-	"54786,State of Pernambuco,Brazil",
-	"54789,State of Pernambuco,Brazil",
-	"54792,State of Pernambuco,Brazil",
-	"55038,State of Pernambuco,Brazil",
-	"55040,State of Pernambuco,Brazil",
-	"55291,State of Pernambuco,Brazil",
-	"55294,State of Pernambuco,Brazil",
-	"55299,State of Pernambuco,Brazil",
-	"55606,State of Pernambuco,Brazil",
-	"55641,State of Pernambuco,Brazil",
-	"55642,State of Pernambuco,Brazil",
-	"55643,State of Pernambuco,Brazil",
-	"55644,State of Pernambuco,Brazil",
-	"56302,State of Pernambuco,Brazil",
-	"56312,State of Pernambuco,Brazil",
-	"56313,State of Pernambuco,Brazil",
-	"56318,State of Pernambuco,Brazil",
-	"56325,State of Pernambuco,Brazil",
-	"56328,State of Pernambuco,Brazil",
-	"56912,State of Pernambuco,Brazil",
-	"57018,State of Alagoas,Brazil",
-	"57025,State of Alagoas,Brazil",
-	"57038,State of Alagoas,Brazil",
-	"57044,State of Alagoas,Brazil",
-	"57046,State of Alagoas,Brazil",
-	"57048,State of Alagoas,Brazil",
-	"57062,State of Alagoas,Brazil",
-	"57070,State of Alagoas,Brazil",
-	"57071,State of Alagoas,Brazil",
-	"57072,State of Alagoas,Brazil",
-	"57073,State of Alagoas,Brazil",
-	"57075,State of Alagoas,Brazil",
-	"57081,State of Alagoas,Brazil",
-	"57082,State of Alagoas,Brazil",
-	"57085,State of Alagoas,Brazil",
-	"57086,State of Alagoas,Brazil",
-	"57303,State of Alagoas,Brazil",
-	"57306,State of Alagoas,Brazil",
-	"57309,State of Alagoas,Brazil",
-	"58013,State of Paraiba,Brazil",
-	"58015,State of Paraiba,Brazil",
-	"58033,State of Paraiba,Brazil",
-	"58046,State of Paraiba,Brazil",
-	"58051,State of Paraiba,Brazil",
-	"58052,State of Paraiba,Brazil",
-	"58053,State of Paraiba,Brazil",
-	"58059,State of Paraiba,Brazil",
-	"58060,State of Paraiba,Brazil",
-	"58063,State of Paraiba,Brazil",
-	"58065,State of Paraiba,Brazil",
-	"58066,State of Paraiba,Brazil",
-	"58068,State of Paraiba,Brazil",
-	"58070,State of Paraiba,Brazil",
-	"58071,State of Paraiba,Brazil",
-	"58077,State of Paraiba,Brazil",
-	"58083,State of Paraiba,Brazil",
-	"58084,State of Paraiba,Brazil",
-	"58085,State of Paraiba,Brazil",
-	"58087,State of Paraiba,Brazil",
-	"58088,State of Paraiba,Brazil",
-	"58300,State of Paraiba,Brazil",
-	"58301,State of Paraiba,Brazil",
-	"58303,State of Paraiba,Brazil",
-	"58400,State of Paraiba,Brazil",
-	"58401,State of Paraiba,Brazil",
-	"58407,State of Paraiba,Brazil",
-	"58408,State of Paraiba,Brazil",
-	"58410,State of Paraiba,Brazil",
-	"58430,State of Paraiba,Brazil",
-	"58434,State of Paraiba,Brazil",
-	"58703,State of Paraiba,Brazil",
-	"58704,State of Paraiba,Brazil",
-	"58706,State of Paraiba,Brazil",
-	"58707,State of Paraiba,Brazil",
-	"59071,State of Rio Grande do Norte,Brazil",
-	"59072,State of Rio Grande do Norte,Brazil",
-	"59073,State of Rio Grande do Norte,Brazil",
-	"59074,State of Rio Grande do Norte,Brazil",
-	"59075,State of Rio Grande do Norte,Brazil",
-	"59080,State of Rio Grande do Norte,Brazil",
-	"59082,State of Rio Grande do Norte,Brazil",
-	"59108,State of Rio Grande do Norte,Brazil",
-	"59115,State of Rio Grande do Norte,Brazil",
-	"59133,State of Rio Grande do Norte,Brazil",
-	"59139,State of Rio Grande do Norte,Brazil",
-	"59144,State of Rio Grande do Norte,Brazil",
-	"59146,State of Rio Grande do Norte,Brazil",
-	"59147,State of Rio Grande do Norte,Brazil",
-	"59148,State of Rio Grande do Norte,Brazil",
-	"59153,State of Rio Grande do Norte,Brazil",
-	"59155,State of Rio Grande do Norte,Brazil",
-	"59158,State of Rio Grande do Norte,Brazil",
-	"59159,State of Rio Grande do Norte,Brazil",
-	"59600,State of Rio Grande do Norte,Brazil",
-	"59607,State of Rio Grande do Norte,Brazil",
-	"59609,State of Rio Grande do Norte,Brazil",
-	"59610,State of Rio Grande do Norte,Brazil",
-	"59616,State of Rio Grande do Norte,Brazil",
-	"59621,State of Rio Grande do Norte,Brazil",
-	"59625,State of Rio Grande do Norte,Brazil",
-	"59628,State of Rio Grande do Norte,Brazil",
-	"59631,State of Rio Grande do Norte,Brazil",
-	"59633,State of Rio Grande do Norte,Brazil",
-	"60712,Ceara,Brazil",
-	"60750,Ceara,Brazil",
-	"60762,Ceara,Brazil",
-	"60810,Ceara,Brazil",
-	"60830,Ceara,Brazil",
-	"60831,Ceara,Brazil",
-	"60834,Ceara,Brazil",
-	"60836,Ceara,Brazil",
-	"60844,Ceara,Brazil",
-	"60860,Ceara,Brazil",
-	"60870,Ceara,Brazil",
-	"60871,Ceara,Brazil",
-	"60872,Ceara,Brazil",
-	"60873,Ceara,Brazil",
-	"60877,Ceara,Brazil",
-	"61602,Ceara,Brazil",
-	"61618,Ceara,Brazil",
-	"61619,Ceara,Brazil",
-	"61628,Ceara,Brazil",
-	"61629,Ceara,Brazil",
-	"61634,Ceara,Brazil",
-	"61656,Ceara,Brazil",
-	"61909,Ceara,Brazil",
-	"61910,Ceara,Brazil",
-	"61914,Ceara,Brazil",
-	"61917,Ceara,Brazil",
-	"61925,Ceara,Brazil",
-	"62050,Ceara,Brazil",
-	"63021,Ceara,Brazil",
-	"63043,Ceara,Brazil",
-	"63050,Ceara,Brazil",
-	"63051,Ceara,Brazil",
-	"64000,State of Piaui,Brazil",
-	"64004,State of Piaui,Brazil",
-	"64006,State of Piaui,Brazil",
-	"64008,State of Piaui,Brazil",
-	"64010,State of Piaui,Brazil",
-	"64012,State of Piaui,Brazil",
-	"64019,State of Piaui,Brazil",
-	"64028,State of Piaui,Brazil",
-	"64029,State of Piaui,Brazil",
-	"64033,State of Piaui,Brazil",
-	"64034,State of Piaui,Brazil",
-	"64035,State of Piaui,Brazil",
-	"64039,State of Piaui,Brazil",
-	"64041,State of Piaui,Brazil",
-	"64045,State of Piaui,Brazil",
-	"64048,State of Piaui,Brazil",
-	"64049,State of Piaui,Brazil",
-	"64051,State of Piaui,Brazil",
-	"64057,State of Piaui,Brazil",
-	"64060,State of Piaui,Brazil",
-	"64062,State of Piaui,Brazil",
-	"64064,State of Piaui,Brazil",
-	"64065,State of Piaui,Brazil",
-	"64071,State of Piaui,Brazil",
-	"64073,State of Piaui,Brazil",
-	"64075,State of Piaui,Brazil",
-	"64076,State of Piaui,Brazil",
-	"64078,State of Piaui,Brazil",
-	"64088,State of Piaui,Brazil",
-	"64093,State of Piaui,Brazil",
-	"64200,State of Piaui,Brazil",
-	"64210,State of Piaui,Brazil",
-	"64216,State of Piaui,Brazil",
-	"64218,State of Piaui,Brazil",
-	"65043,State of Maranhao,Brazil",
-	"65049,State of Maranhao,Brazil",
-	"65052,State of Maranhao,Brazil",
-	"65055,State of Maranhao,Brazil",
-	"65056,State of Maranhao,Brazil",
-	"65058,State of Maranhao,Brazil",
-	"65061,State of Maranhao,Brazil",
-	"65062,State of Maranhao,Brazil",
-	"65063,State of Maranhao,Brazil",
-	"65065,State of Maranhao,Brazil",
-	"65071,State of Maranhao,Brazil",
-	"65073,State of Maranhao,Brazil",
-	"65076,State of Maranhao,Brazil",
-	"65081,State of Maranhao,Brazil",
-	"65099,State of Maranhao,Brazil",
-	"65600,State of Maranhao,Brazil",
-	"65604,State of Maranhao,Brazil",
-	"65606,State of Maranhao,Brazil",
-	"65607,State of Maranhao,Brazil",
-	"65631,State of Maranhao,Brazil",
-	"65632,State of Maranhao,Brazil",
-	"65633,State of Maranhao,Brazil",
-	"65634,State of Maranhao,Brazil",
-	"65901,State of Maranhao,Brazil",
-	"65903,State of Maranhao,Brazil",
-	"65906,State of Maranhao,Brazil",
-	"65911,State of Maranhao,Brazil",
-	"65912,State of Maranhao,Brazil",
-	"65913,State of Maranhao,Brazil",
-	"65915,State of Maranhao,Brazil",
-	"65919,State of Maranhao,Brazil",
-	"66026,State of Para,Brazil",
-	"66032,State of Para,Brazil",
-	"66077,State of Para,Brazil",
-	"66115,State of Para,Brazil",
-	"66610,State of Para,Brazil",
-	"66613,State of Para,Brazil",
-	"66617,State of Para,Brazil",
-	"66630,State of Para,Brazil",
-	"66640,State of Para,Brazil",
-	"66643,State of Para,Brazil",
-	"66691,State of Para,Brazil",
-	"66813,State of Para,Brazil",
-	"66820,State of Para,Brazil",
-	"66821,State of Para,Brazil",
-	"66825,State of Para,Brazil",
-	"66830,State of Para,Brazil",
-	"66842,State of Para,Brazil",
-	"66917,State of Para,Brazil",
-	"67010,State of Para,Brazil",
-	"67015,State of Para,Brazil",
-	"67020,State of Para,Brazil",
-	"67035,State of Para,Brazil",
-	"67100,State of Para,Brazil",
-	"67113,State of Para,Brazil",
-	"67124,State of Para,Brazil",
-	"67145,State of Para,Brazil",
-	"67146,State of Para,Brazil",
-	"68015,State of Para,Brazil",
-	"68025,State of Para,Brazil",
-	"68030,State of Para,Brazil",
-	"68035,State of Para,Brazil",
-	"68040,State of Para,Brazil",
-	"68046,State of Para,Brazil",
-	"68047,State of Para,Brazil",
-	"68180,State of Para,Brazil",
-	"68181,State of Para,Brazil",
-	"68374,State of Para,Brazil",
-	"68376,State of Para,Brazil",
-	"68459,State of Para,Brazil",
-	"68500,State of Para,Brazil",
-	"68502,State of Para,Brazil",
-	"68503,State of Para,Brazil",
-	"68506,State of Para,Brazil",
-	"68509,State of Para,Brazil",
-	"68550,State of Para,Brazil",
-	"68551,State of Para,Brazil",
-	"68552,State of Para,Brazil",
-	"68554,State of Para,Brazil",
-	"68555,State of Para,Brazil",
-	"68557,State of Para,Brazil",
-	"68625,State of Para,Brazil",
-	"68626,State of Para,Brazil",
-	"68628,State of Para,Brazil",
-	"68700,State of Para,Brazil",
-	"68702,State of Para,Brazil",
-	"68703,State of Para,Brazil",
-	"68740,State of Para,Brazil",
-	"68741,State of Para,Brazil",
-	"68743,State of Para,Brazil",
-	"68744,State of Para,Brazil",
-	"68745,State of Para,Brazil",
-	"68746,State of Para,Brazil",
-	"68900,State of Amapa,Brazil",
-	"68901,State of Amapa,Brazil",
-	"68902,State of Amapa,Brazil",
-	"68903,State of Amapa,Brazil",
-	"68904,State of Amapa,Brazil",
-	"68905,State of Amapa,Brazil",
-	"68906,State of Amapa,Brazil",
-	"68907,State of Amapa,Brazil",
-	"68908,State of Amapa,Brazil",
-	"68911,State of Amapa,Brazil",
-	"69007,State of Amazonas,Brazil",
-	"69008,State of Amazonas,Brazil",
-	"69009,State of Amazonas,Brazil",
-	"69015,State of Amazonas,Brazil",
-	"69017,State of Amazonas,Brazil",
-	"69021,State of Amazonas,Brazil",
-	"69022,State of Amazonas,Brazil",
-	"69023,State of Amazonas,Brazil",
-	"69027,State of Amazonas,Brazil",
-	"69028,State of Amazonas,Brazil",
-	"69029,State of Amazonas,Brazil",
-	"69035,State of Amazonas,Brazil",
-	"69038,State of Amazonas,Brazil",
-	"69039,State of Amazonas,Brazil",
-	"69041,State of Amazonas,Brazil",
-	"69043,State of Amazonas,Brazil",
-	"69044,State of Amazonas,Brazil",
-	"69048,State of Amazonas,Brazil",
-	"69049,State of Amazonas,Brazil",
-	"69053,State of Amazonas,Brazil",
-	"69054,State of Amazonas,Brazil",
-	"69055,State of Amazonas,Brazil",
-	"69058,State of Amazonas,Brazil",
-	"69059,State of Amazonas,Brazil",
-	"69072,State of Amazonas,Brazil",
-	"69073,State of Amazonas,Brazil",
-	"69075,State of Amazonas,Brazil",
-	"69076,State of Amazonas,Brazil",
-	"69080,State of Amazonas,Brazil",
-	"69082,State of Amazonas,Brazil",
-	"69083,State of Amazonas,Brazil",
-	"69084,State of Amazonas,Brazil",
-	"69085,State of Amazonas,Brazil",
-	"69086,State of Amazonas,Brazil",
-	"69088,State of Amazonas,Brazil",
-	"69089,State of Amazonas,Brazil",
-	"69090,State of Amazonas,Brazil",
-	"69092,State of Amazonas,Brazil",
-	"69093,State of Amazonas,Brazil",
-	"69094,State of Amazonas,Brazil",
-	"69095,State of Amazonas,Brazil",
-	"69096,State of Amazonas,Brazil",
-	"69097,State of Amazonas,Brazil",
-	"69098,State of Amazonas,Brazil",
-	"69099,State of Amazonas,Brazil",
-	"69152,State of Amazonas,Brazil",
-	"69301,State of Roraima,Brazil",
-	"69303,State of Roraima,Brazil",
-	"69304,State of Roraima,Brazil",
-	"69305,State of Roraima,Brazil",
-	"69306,State of Roraima,Brazil",
-	"69307,State of Roraima,Brazil",
-	"69308,State of Roraima,Brazil",
-	"69309,State of Roraima,Brazil",
-	"69310,State of Roraima,Brazil",
-	"69311,State of Roraima,Brazil",
-	"69312,State of Roraima,Brazil",
-	"69313,State of Roraima,Brazil",
-	"69315,State of Roraima,Brazil",
-	"69316,State of Roraima,Brazil",
-	"69318,State of Roraima,Brazil",
-	"69904,State of Acre,Brazil",
-	"69909,State of Acre,Brazil",
-	"69911,State of Acre,Brazil",
-	"69912,State of Acre,Brazil",
-	"69914,State of Acre,Brazil",
-	"69917,State of Acre,Brazil",
-	"69918,State of Acre,Brazil",
-	"72801,State of Goias,Brazil",
-	"72814,State of Goias,Brazil",
-	"72816,State of Goias,Brazil",
-	"72820,State of Goias,Brazil",
-	"72834,State of Goias,Brazil",
-	"72837,State of Goias,Brazil",
-	"72850,State of Goias,Brazil",
-	"72851,State of Goias,Brazil",
-	"72854,State of Goias,Brazil",
-	"72855,State of Goias,Brazil",
-	"72858,State of Goias,Brazil",
-	"72876,State of Goias,Brazil",
-	"73750,State of Goias,Brazil",
-	"73802,State of Goias,Brazil",
-	"73805,State of Goias,Brazil",
-	"73807,State of Goias,Brazil",
-	"73808,State of Goias,Brazil",
-	"73809,State of Goias,Brazil",
-	"73813,State of Goias,Brazil",
-	"73814,State of Goias,Brazil",
-	"73819,State of Goias,Brazil",
-	"74369,State of Goias,Brazil",
-	"74370,State of Goias,Brazil",
-	"74372,State of Goias,Brazil",
-	"74390,State of Goias,Brazil",
-	"74391,State of Goias,Brazil",
-	"74460,State of Goias,Brazil",
-	"74461,State of Goias,Brazil",
-	"74463,State of Goias,Brazil",
-	"74465,State of Goias,Brazil",
-	"74470,State of Goias,Brazil",
-	"74473,State of Goias,Brazil",
-	"74474,State of Goias,Brazil",
-	"74475,State of Goias,Brazil",
-	"74476,State of Goias,Brazil",
-	"74477,State of Goias,Brazil",
-	"74480,State of Goias,Brazil",
-	"74481,State of Goias,Brazil",
-	"74482,State of Goias,Brazil",
-	"74483,State of Goias,Brazil",
-	"74484,State of Goias,Brazil",
-	"74487,State of Goias,Brazil",
-	"74490,State of Goias,Brazil",
-	"74493,State of Goias,Brazil",
-	"74495,State of Goias,Brazil",
-	"74565,State of Goias,Brazil",
-	"74590,State of Goias,Brazil",
-	"74591,State of Goias,Brazil",
-	"74672,State of Goias,Brazil",
-	"74680,State of Goias,Brazil",
-	"74681,State of Goias,Brazil",
-	"74683,State of Goias,Brazil",
-	"74685,State of Goias,Brazil",
-	"74690,State of Goias,Brazil",
-	"74691,State of Goias,Brazil",
-	"74692,State of Goias,Brazil",
-	"74740,State of Goias,Brazil",
-	"74775,State of Goias,Brazil",
-	"74884,State of Goias,Brazil",
-	"74885,State of Goias,Brazil",
-	"74919,State of Goias,Brazil",
-	"74922,State of Goias,Brazil",
-	"74923,State of Goias,Brazil",
-	"74925,State of Goias,Brazil",
-	"74926,State of Goias,Brazil",
-	"74942,State of Goias,Brazil",
-	"74947,State of Goias,Brazil",
-	"74950,State of Goias,Brazil",
-	"74954,State of Goias,Brazil",
-	"74955,State of Goias,Brazil",
-	"74956,State of Goias,Brazil",
-	"74959,State of Goias,Brazil",
-	"74962,State of Goias,Brazil",
-	"74963,State of Goias,Brazil",
-	"74964,State of Goias,Brazil",
-	"74965,State of Goias,Brazil",
-	"74968,State of Goias,Brazil",
-	"74982,State of Goias,Brazil",
-	"74988,State of Goias,Brazil",
-	"74989,State of Goias,Brazil",
-	"74993,State of Goias,Brazil",
-	"75071,State of Goias,Brazil",
-	"75073,State of Goias,Brazil",
-	"75085,State of Goias,Brazil",
-	"75101,State of Goias,Brazil",
-	"75103,State of Goias,Brazil",
-	"75104,State of Goias,Brazil",
-	"75133,State of Goias,Brazil",
-	"75135,State of Goias,Brazil",
-	"75515,State of Goias,Brazil",
-	"75535,State of Goias,Brazil",
-	"75705,State of Goias,Brazil",
-	"75706,State of Goias,Brazil",
-	"75709,State of Goias,Brazil",
-	"75710,State of Goias,Brazil",
-	"75800,State of Goias,Brazil",
-	"75801,State of Goias,Brazil",
-	"75802,State of Goias,Brazil",
-	"75805,State of Goias,Brazil",
-	"75807,State of Goias,Brazil",
-	"75903,State of Goias,Brazil",
-	"75904,State of Goias,Brazil",
-	"75906,State of Goias,Brazil",
-	"75907,State of Goias,Brazil",
-	"75909,State of Goias,Brazil",
-	"76801,State of Rondonia,Brazil",
-	"76804,State of Rondonia,Brazil",
-	"76806,State of Rondonia,Brazil",
-	"76807,State of Rondonia,Brazil",
-	"76809,State of Rondonia,Brazil",
-	"76810,State of Rondonia,Brazil",
-	"76811,State of Rondonia,Brazil",
-	"76812,State of Rondonia,Brazil",
-	"76813,State of Rondonia,Brazil",
-	"76814,State of Rondonia,Brazil",
-	"76820,State of Rondonia,Brazil",
-	"76821,State of Rondonia,Brazil",
-	"76824,State of Rondonia,Brazil",
-	"76825,State of Rondonia,Brazil",
-	"76829,State of Rondonia,Brazil",
-	"76870,State of Rondonia,Brazil",
-	"76871,State of Rondonia,Brazil",
-	"76872,State of Rondonia,Brazil",
-	"76876,State of Rondonia,Brazil",
-	"76900,State of Rondonia,Brazil",
-	"76901,State of Rondonia,Brazil",
-	"76907,State of Rondonia,Brazil",
-	"76909,State of Rondonia,Brazil",
-	"76912,State of Rondonia,Brazil",
-	"76913,State of Rondonia,Brazil",
-	"76914,State of Rondonia,Brazil",
-	"76964,State of Rondonia,Brazil",
-	"77060,State of Tocantins,Brazil",
-	"77061,State of Tocantins,Brazil",
-	"77062,State of Tocantins,Brazil",
-	"77066,State of Tocantins,Brazil",
-	"77080,State of Tocantins,Brazil",
-	"77410,State of Tocantins,Brazil",
-	"77415,State of Tocantins,Brazil",
-	"77807,State of Tocantins,Brazil",
-	"77824,State of Tocantins,Brazil",
-	"77827,State of Tocantins,Brazil",
-	"78040,State of Mato Grosso,Brazil",
-	"78042,State of Mato Grosso,Brazil",
-	"78043,State of Mato Grosso,Brazil",
-	"78048,State of Mato Grosso,Brazil",
-	"78049,State of Mato Grosso,Brazil",
-	"78050,State of Mato Grosso,Brazil",
-	"78051,State of Mato Grosso,Brazil",
-	"78052,State of Mato Grosso,Brazil",
-	"78053,State of Mato Grosso,Brazil",
-	"78055,State of Mato Grosso,Brazil",
-	"78056,State of Mato Grosso,Brazil",
-	"78058,State of Mato Grosso,Brazil",
-	"78065,State of Mato Grosso,Brazil",
-	"78068,State of Mato Grosso,Brazil",
-	"78070,State of Mato Grosso,Brazil",
-	"78075,State of Mato Grosso,Brazil",
-	"78076,State of Mato Grosso,Brazil",
-	"78080,State of Mato Grosso,Brazil",
-	"78085,State of Mato Grosso,Brazil",
-	"78087,State of Mato Grosso,Brazil",
-	"78088,State of Mato Grosso,Brazil",
-	"78089,State of Mato Grosso,Brazil",
-	"78090,State of Mato Grosso,Brazil",
-	"78095,State of Mato Grosso,Brazil",
-	"78098,State of Mato Grosso,Brazil",
-	"78106,State of Mato Grosso,Brazil",
-	"78110,State of Mato Grosso,Brazil",
-	"78115,State of Mato Grosso,Brazil",
-	"78116,State of Mato Grosso,Brazil",
-	"78117,State of Mato Grosso,Brazil",
-	"78118,State of Mato Grosso,Brazil",
-	"78120,State of Mato Grosso,Brazil",
-	"78125,State of Mato Grosso,Brazil",
-	"78128,State of Mato Grosso,Brazil",
-	"78130,State of Mato Grosso,Brazil",
-	"78132,State of Mato Grosso,Brazil",
-	"78135,State of Mato Grosso,Brazil",
-	"78138,State of Mato Grosso,Brazil",
-	"78148,State of Mato Grosso,Brazil",
-	"78150,State of Mato Grosso,Brazil",
-	"78155,State of Mato Grosso,Brazil",
-	"78158,State of Mato Grosso,Brazil",
-	"78164,State of Mato Grosso,Brazil",
-	"78550,State of Mato Grosso,Brazil",
-	"78551,State of Mato Grosso,Brazil",
-	"78552,State of Mato Grosso,Brazil",
-	"78553,State of Mato Grosso,Brazil",
-	"78555,State of Mato Grosso,Brazil",
-	"78556,State of Mato Grosso,Brazil",
-	"78557,State of Mato Grosso,Brazil",
-	"78558,State of Mato Grosso,Brazil",
-	"78559,State of Mato Grosso,Brazil",
-	"78700,State of Mato Grosso,Brazil",
-	"78710,State of Mato Grosso,Brazil",
-	"78714,State of Mato Grosso,Brazil",
-	"78719,State of Mato Grosso,Brazil",
-	"78725,State of Mato Grosso,Brazil",
-	"78734,State of Mato Grosso,Brazil",
-	"78735,State of Mato Grosso,Brazil",
-	"78736,State of Mato Grosso,Brazil",
-	"78740,State of Mato Grosso,Brazil",
-	"78745,State of Mato Grosso,Brazil",
-	"78746,State of Mato Grosso,Brazil",
-	"78750,State of Mato Grosso,Brazil",
-	"79005,State of Mato Grosso do Sul,Brazil",
-	"79015,State of Mato Grosso do Sul,Brazil",
-	"79017,State of Mato Grosso do Sul,Brazil",
-	"79032,State of Mato Grosso do Sul,Brazil",
-	"79035,State of Mato Grosso do Sul,Brazil",
-	"79036,State of Mato Grosso do Sul,Brazil",
-	"79037,State of Mato Grosso do Sul,Brazil",
-	"79040,State of Mato Grosso do Sul,Brazil",
-	"79043,State of Mato Grosso do Sul,Brazil",
-	"79044,State of Mato Grosso do Sul,Brazil",
-	"79045,State of Mato Grosso do Sul,Brazil",
-	"79046,State of Mato Grosso do Sul,Brazil",
-	"79050,State of Mato Grosso do Sul,Brazil",
-	"79051,State of Mato Grosso do Sul,Brazil",
-	"79052,State of Mato Grosso do Sul,Brazil",
-	"79060,State of Mato Grosso do Sul,Brazil",
-	"79062,State of Mato Grosso do Sul,Brazil",
-	"79063,State of Mato Grosso do Sul,Brazil",
-	"79070,State of Mato Grosso do Sul,Brazil",
-	"79071,State of Mato Grosso do Sul,Brazil",
-	"79072,State of Mato Grosso do Sul,Brazil",
-	"79073,State of Mato Grosso do Sul,Brazil",
-	"79074,State of Mato Grosso do Sul,Brazil",
-	"79075,State of Mato Grosso do Sul,Brazil",
-	"79077,State of Mato Grosso do Sul,Brazil",
-	"79081,State of Mato Grosso do Sul,Brazil",
-	"79090,State of Mato Grosso do Sul,Brazil",
-	"79091,State of Mato Grosso do Sul,Brazil",
-	"79094,State of Mato Grosso do Sul,Brazil",
-	"79095,State of Mato Grosso do Sul,Brazil",
-	"79096,State of Mato Grosso do Sul,Brazil",
-	"79100,State of Mato Grosso do Sul,Brazil",
-	"79101,State of Mato Grosso do Sul,Brazil",
-	"79103,State of Mato Grosso do Sul,Brazil",
-	"79105,State of Mato Grosso do Sul,Brazil",
-	"79106,State of Mato Grosso do Sul,Brazil",
-	"79110,State of Mato Grosso do Sul,Brazil",
-	"79112,State of Mato Grosso do Sul,Brazil",
-	"79115,State of Mato Grosso do Sul,Brazil",
-	"79116,State of Mato Grosso do Sul,Brazil",
-	"79118,State of Mato Grosso do Sul,Brazil",
-	"79321,State of Mato Grosso do Sul,Brazil",
-	"79601,State of Mato Grosso do Sul,Brazil",
-	"79612,State of Mato Grosso do Sul,Brazil",
-	"79621,State of Mato Grosso do Sul,Brazil",
-	"79630,State of Mato Grosso do Sul,Brazil",
-	"79822,State of Mato Grosso do Sul,Brazil",
-	"79823,State of Mato Grosso do Sul,Brazil",
-	"79831,State of Mato Grosso do Sul,Brazil",
-	"79833,State of Mato Grosso do Sul,Brazil",
-	"79840,State of Mato Grosso do Sul,Brazil",
-	"79841,State of Mato Grosso do Sul,Brazil",
-	"79843,State of Mato Grosso do Sul,Brazil",
-	"80215,State of Parana,Brazil",
-	"81150,State of Parana,Brazil",
-	"81170,State of Parana,Brazil",
-	"81230,State of Parana,Brazil",
-	"81280,State of Parana,Brazil",
-	"81320,State of Parana,Brazil",
-	"81452,State of Parana,Brazil",
-	"81460,State of Parana,Brazil",
-	"81470,State of Parana,Brazil",
-	"81480,State of Parana,Brazil",
-	"81490,State of Parana,Brazil",
-	"81580,State of Parana,Brazil",
-	"81590,State of Parana,Brazil",
-	"81670,State of Parana,Brazil",
-	"81730,State of Parana,Brazil",
-	"81860,State of Parana,Brazil",
-	"81880,State of Parana,Brazil",
-	"81930,State of Parana,Brazil",
-	"81935,State of Parana,Brazil",
-	"81940,State of Parana,Brazil",
-	"82020,State of Parana,Brazil",
-	"82025,State of Parana,Brazil",
-	"82030,State of Parana,Brazil",
-	"82220,State of Parana,Brazil",
-	"82300,State of Parana,Brazil",
-	"82310,State of Parana,Brazil",
-	"82400,State of Parana,Brazil",
-	"82520,State of Parana,Brazil",
-	"82720,State of Parana,Brazil",
-	"82820,State of Parana,Brazil",
-	"82840,State of Parana,Brazil",
-	"83005,State of Parana,Brazil",
-	"83010,State of Parana,Brazil",
-	"83015,State of Parana,Brazil",
-	"83020,State of Parana,Brazil",
-	"83025,State of Parana,Brazil",
-	"83030,State of Parana,Brazil",
-	"83045,State of Parana,Brazil",
-	"83050,State of Parana,Brazil",
-	"83055,State of Parana,Brazil",
-	"83060,State of Parana,Brazil",
-	"83065,State of Parana,Brazil",
-	"83070,State of Parana,Brazil",
-	"83075,State of Parana,Brazil",
-	"83090,State of Parana,Brazil",
-	"83203,State of Parana,Brazil",
-	"83209,State of Parana,Brazil",
-	"83215,State of Parana,Brazil",
-	"83301,State of Parana,Brazil",
-	"83303,State of Parana,Brazil",
-	"83305,State of Parana,Brazil",
-	"83306,State of Parana,Brazil",
-	"83310,State of Parana,Brazil",
-	"83311,State of Parana,Brazil",
-	"83314,State of Parana,Brazil",
-	"83320,State of Parana,Brazil",
-	"83323,State of Parana,Brazil",
-	"83325,State of Parana,Brazil",
-	"83326,State of Parana,Brazil",
-	"83327,State of Parana,Brazil",
-	"83328,State of Parana,Brazil",
-	"83330,State of Parana,Brazil",
-	"83331,State of Parana,Brazil",
-	"83401,State of Parana,Brazil",
-	"83403,State of Parana,Brazil",
-	"83404,State of Parana,Brazil",
-	"83405,State of Parana,Brazil",
-	"83407,State of Parana,Brazil",
-	"83410,State of Parana,Brazil",
-	"83411,State of Parana,Brazil",
-	"83501,State of Parana,Brazil",
-	"83503,State of Parana,Brazil",
-	"83505,State of Parana,Brazil",
-	"83506,State of Parana,Brazil",
-	"83507,State of Parana,Brazil",
-	"83512,State of Parana,Brazil",
-	"83601,State of Parana,Brazil",
-	"83602,State of Parana,Brazil",
-	"83603,State of Parana,Brazil",
-	"83604,State of Parana,Brazil",
-	"83605,State of Parana,Brazil",
-	"83606,State of Parana,Brazil",
-	"83607,State of Parana,Brazil",
-	"83701,State of Parana,Brazil",
-	"83702,State of Parana,Brazil",
-	"83703,State of Parana,Brazil",
-	"83705,State of Parana,Brazil",
-	"83706,State of Parana,Brazil",
-	"83707,State of Parana,Brazil",
-	"83709,State of Parana,Brazil",
-	"83823,State of Parana,Brazil",
-	"83829,State of Parana,Brazil",
-	"83833,State of Parana,Brazil",
-	"84010,State of Parana,Brazil",
-	"84015,State of Parana,Brazil",
-	"84016,State of Parana,Brazil",
-	"84025,State of Parana,Brazil",
-	"84026,State of Parana,Brazil",
-	"84030,State of Parana,Brazil",
-	"84031,State of Parana,Brazil",
-	"84032,State of Parana,Brazil",
-	"84036,State of Parana,Brazil",
-	"84043,State of Parana,Brazil",
-	"84045,State of Parana,Brazil",
-	"84046,State of Parana,Brazil",
-	"84051,State of Parana,Brazil",
-	"84053,State of Parana,Brazil",
-	"84061,State of Parana,Brazil",
-	"84062,State of Parana,Brazil",
-	"84064,State of Parana,Brazil",
-	"84070,State of Parana,Brazil",
-	"84072,State of Parana,Brazil",
-	"85010,State of Parana,Brazil",
-	"85067,State of Parana,Brazil",
-	"85502,State of Parana,Brazil",
-	"85503,State of Parana,Brazil",
-	"85505,State of Parana,Brazil",
-	"85507,State of Parana,Brazil",
-	"85508,State of Parana,Brazil",
-	"85509,State of Parana,Brazil",
-	"85602,State of Parana,Brazil",
-	"85604,State of Parana,Brazil",
-	"85605,State of Parana,Brazil",
-	"85801,State of Parana,Brazil",
-	"85803,State of Parana,Brazil",
-	"85804,State of Parana,Brazil",
-	"85805,State of Parana,Brazil",
-	"85806,State of Parana,Brazil",
-	"85807,State of Parana,Brazil",
-	"85810,State of Parana,Brazil",
-	"85811,State of Parana,Brazil",
-	"85812,State of Parana,Brazil",
-	"85813,State of Parana,Brazil",
-	"85814,State of Parana,Brazil",
-	"85815,State of Parana,Brazil",
-	"85816,State of Parana,Brazil",
-	"85817,State of Parana,Brazil",
-	"85818,State of Parana,Brazil",
-	"85819,State of Parana,Brazil",
-	"85851,State of Parana,Brazil",
-	"85852,State of Parana,Brazil",
-	"85853,State of Parana,Brazil",
-	"85855,State of Parana,Brazil",
-	"85856,State of Parana,Brazil",
-	"85858,State of Parana,Brazil",
-	"85859,State of Parana,Brazil",
-	"85861,State of Parana,Brazil",
-	"85862,State of Parana,Brazil",
-	"85867,State of Parana,Brazil",
-	"85903,State of Parana,Brazil",
-	"85904,State of Parana,Brazil",
-	"85905,State of Parana,Brazil",
-	"85906,State of Parana,Brazil",
-	"85907,State of Parana,Brazil",
-	"85908,State of Parana,Brazil",
-	"85911,State of Parana,Brazil",
-	"86015,State of Parana,Brazil",
-	"86039,State of Parana,Brazil",
-	"86043,State of Parana,Brazil",
-	"86044,State of Parana,Brazil",
-	"86047,State of Parana,Brazil",
-	"86055,State of Parana,Brazil",
-	"86185,State of Parana,Brazil",
-	"86191,State of Parana,Brazil",
-	"86701,State of Parana,Brazil",
-	"86703,State of Parana,Brazil",
-	"86704,State of Parana,Brazil",
-	"86705,State of Parana,Brazil",
-	"86706,State of Parana,Brazil",
-	"86707,State of Parana,Brazil",
-	"86709,State of Parana,Brazil",
-	"86802,State of Parana,Brazil",
-	"86804,State of Parana,Brazil",
-	"86805,State of Parana,Brazil",
-	"86806,State of Parana,Brazil",
-	"86810,State of Parana,Brazil",
-	"86811,State of Parana,Brazil",
-	"86812,State of Parana,Brazil",
-	"86813,State of Parana,Brazil",
-	"87005,State of Parana,Brazil",
-	"87015,State of Parana,Brazil",
-	"87023,State of Parana,Brazil",
-	"87033,State of Parana,Brazil",
-	"87035,State of Parana,Brazil",
-	"87040,State of Parana,Brazil",
-	"87043,State of Parana,Brazil",
-	"87045,State of Parana,Brazil",
-	"87047,State of Parana,Brazil",
-	"87050,State of Parana,Brazil",
-	"87053,State of Parana,Brazil",
-	"87060,State of Parana,Brazil",
-	"87075,State of Parana,Brazil",
-	"87080,State of Parana,Brazil",
-	"87083,State of Parana,Brazil",
-	"87111,State of Parana,Brazil",
-	"87112,State of Parana,Brazil",
-	"87113,State of Parana,Brazil",
-	"87114,State of Parana,Brazil",
-	"87301,State of Parana,Brazil",
-	"87303,State of Parana,Brazil",
-	"87305,State of Parana,Brazil",
-	"87309,State of Parana,Brazil",
-	"87310,State of Parana,Brazil",
-	"87503,State of Parana,Brazil",
-	"87505,State of Parana,Brazil",
-	"87506,State of Parana,Brazil",
-	"87703,State of Parana,Brazil",
-	"87706,State of Parana,Brazil",
-	"87710,State of Parana,Brazil",
-	"87720,State of Parana,Brazil",
-	"88032,State of Santa Catarina,Brazil",
-	"88034,State of Santa Catarina,Brazil",
-	"88036,State of Santa Catarina,Brazil",
-	"88037,State of Santa Catarina,Brazil",
-	"88040,State of Santa Catarina,Brazil",
-	"88047,State of Santa Catarina,Brazil",
-	"88048,State of Santa Catarina,Brazil",
-	"88049,State of Santa Catarina,Brazil",
-	"88050,State of Santa Catarina,Brazil",
-	"88052,State of Santa Catarina,Brazil",
-	"88053,State of Santa Catarina,Brazil",
-	"88054,State of Santa Catarina,Brazil",
-	"88056,State of Santa Catarina,Brazil",
-	"88058,State of Santa Catarina,Brazil",
-	"88060,State of Santa Catarina,Brazil",
-	"88062,State of Santa Catarina,Brazil",
-	"88063,State of Santa Catarina,Brazil",
-	"88064,State of Santa Catarina,Brazil",
-	"88066,State of Santa Catarina,Brazil",
-	"88067,State of Santa Catarina,Brazil",
-	"88102,State of Santa Catarina,Brazil",
-	"88103,State of Santa Catarina,Brazil",
-	"88106,State of Santa Catarina,Brazil",
-	"88107,State of Santa Catarina,Brazil",
-	"88108,State of Santa Catarina,Brazil",
-	"88111,State of Santa Catarina,Brazil",
-	"88113,State of Santa Catarina,Brazil",
-	"88115,State of Santa Catarina,Brazil",
-	"88122,State of Santa Catarina,Brazil",
-	"88130,State of Santa Catarina,Brazil",
-	"88131,State of Santa Catarina,Brazil",
-	"88132,State of Santa Catarina,Brazil",
-	"88133,State of Santa Catarina,Brazil",
-	"88134,State of Santa Catarina,Brazil",
-	"88135,State of Santa Catarina,Brazil",
-	"88136,State of Santa Catarina,Brazil",
-	"88137,State of Santa Catarina,Brazil",
-	"88138,State of Santa Catarina,Brazil",
-	"88139,State of Santa Catarina,Brazil",
-	"88302,State of Santa Catarina,Brazil",
-	"88306,State of Santa Catarina,Brazil",
-	"88307,State of Santa Catarina,Brazil",
-	"88310,State of Santa Catarina,Brazil",
-	"88311,State of Santa Catarina,Brazil",
-	"88312,State of Santa Catarina,Brazil",
-	"88317,State of Santa Catarina,Brazil",
-	"88332,State of Santa Catarina,Brazil",
-	"88333,State of Santa Catarina,Brazil",
-	"88334,State of Santa Catarina,Brazil",
-	"88336,State of Santa Catarina,Brazil",
-	"88337,State of Santa Catarina,Brazil",
-	"88350,State of Santa Catarina,Brazil",
-	"88351,State of Santa Catarina,Brazil",
-	"88352,State of Santa Catarina,Brazil",
-	"88353,State of Santa Catarina,Brazil",
-	"88354,State of Santa Catarina,Brazil",
-	"88355,State of Santa Catarina,Brazil",
-	"88357,State of Santa Catarina,Brazil",
-	"88504,State of Santa Catarina,Brazil",
-	"88512,State of Santa Catarina,Brazil",
-	"88513,State of Santa Catarina,Brazil",
-	"88514,State of Santa Catarina,Brazil",
-	"88516,State of Santa Catarina,Brazil",
-	"88517,State of Santa Catarina,Brazil",
-	"88521,State of Santa Catarina,Brazil",
-	"88523,State of Santa Catarina,Brazil",
-	"88524,State of Santa Catarina,Brazil",
-	"88526,State of Santa Catarina,Brazil",
-	"88533,State of Santa Catarina,Brazil",
-	"88702,State of Santa Catarina,Brazil",
-	"88704,State of Santa Catarina,Brazil",
-	"88708,State of Santa Catarina,Brazil",
-	"88801,State of Santa Catarina,Brazil",
-	"88802,State of Santa Catarina,Brazil",
-	"88803,State of Santa Catarina,Brazil",
-	"88804,State of Santa Catarina,Brazil",
-	"88805,State of Santa Catarina,Brazil",
-	"88806,State of Santa Catarina,Brazil",
-	"88807,State of Santa Catarina,Brazil",
-	"88808,State of Santa Catarina,Brazil",
-	"88810,State of Santa Catarina,Brazil",
-	"88811,State of Santa Catarina,Brazil",
-	"88813,State of Santa Catarina,Brazil",
-	"88815,State of Santa Catarina,Brazil",
-	"88816,State of Santa Catarina,Brazil",
-	"88817,State of Santa Catarina,Brazil",
-	"88818,State of Santa Catarina,Brazil",
-	"88819,State of Santa Catarina,Brazil",
-	"89010,State of Santa Catarina,Brazil",
-	"89015,State of Santa Catarina,Brazil",
-	"89020,State of Santa Catarina,Brazil",
-	"89021,State of Santa Catarina,Brazil",
-	"89025,State of Santa Catarina,Brazil",
-	"89026,State of Santa Catarina,Brazil",
-	"89027,State of Santa Catarina,Brazil",
-	"89030,State of Santa Catarina,Brazil",
-	"89031,State of Santa Catarina,Brazil",
-	"89032,State of Santa Catarina,Brazil",
-	"89035,State of Santa Catarina,Brazil",
-	"89036,State of Santa Catarina,Brazil",
-	"89037,State of Santa Catarina,Brazil",
-	"89040,State of Santa Catarina,Brazil",
-	"89041,State of Santa Catarina,Brazil",
-	"89042,State of Santa Catarina,Brazil",
-	"89045,State of Santa Catarina,Brazil",
-	"89046,State of Santa Catarina,Brazil",
-	"89050,State of Santa Catarina,Brazil",
-	"89053,State of Santa Catarina,Brazil",
-	"89058,State of Santa Catarina,Brazil",
-	"89060,State of Santa Catarina,Brazil",
-	"89062,State of Santa Catarina,Brazil",
-	"89063,State of Santa Catarina,Brazil",
-	"89065,State of Santa Catarina,Brazil",
-	"89066,State of Santa Catarina,Brazil",
-	"89068,State of Santa Catarina,Brazil",
-	"89069,State of Santa Catarina,Brazil",
-	"89070,State of Santa Catarina,Brazil",
-	"89074,State of Santa Catarina,Brazil",
-	"89075,State of Santa Catarina,Brazil",
-	"89201,State of Santa Catarina,Brazil",
-	"89203,State of Santa Catarina,Brazil",
-	"89206,State of Santa Catarina,Brazil",
-	"89208,State of Santa Catarina,Brazil",
-	"89209,State of Santa Catarina,Brazil",
-	"89210,State of Santa Catarina,Brazil",
-	"89212,State of Santa Catarina,Brazil",
-	"89213,State of Santa Catarina,Brazil",
-	"89214,State of Santa Catarina,Brazil",
-	"89215,State of Santa Catarina,Brazil",
-	"89216,State of Santa Catarina,Brazil",
-	"89217,State of Santa Catarina,Brazil",
-	"89218,State of Santa Catarina,Brazil",
-	"89219,State of Santa Catarina,Brazil",
-	"89220,State of Santa Catarina,Brazil",
-	"89221,State of Santa Catarina,Brazil",
-	"89222,State of Santa Catarina,Brazil",
-	"89223,State of Santa Catarina,Brazil",
-	"89224,State of Santa Catarina,Brazil",
-	"89226,State of Santa Catarina,Brazil",
-	"89227,State of Santa Catarina,Brazil",
-	"89228,State of Santa Catarina,Brazil",
-	"89229,State of Santa Catarina,Brazil",
-	"89230,State of Santa Catarina,Brazil",
-	"89232,State of Santa Catarina,Brazil",
-	"89233,State of Santa Catarina,Brazil",
-	"89234,State of Santa Catarina,Brazil",
-	"89235,State of Santa Catarina,Brazil",
-	"89237,State of Santa Catarina,Brazil",
-	"89239,State of Santa Catarina,Brazil",
-	"89251,State of Santa Catarina,Brazil",
-	"89252,State of Santa Catarina,Brazil",
-	"89253,State of Santa Catarina,Brazil",
-	"89254,State of Santa Catarina,Brazil",
-	"89255,State of Santa Catarina,Brazil",
-	"89256,State of Santa Catarina,Brazil",
-	"89257,State of Santa Catarina,Brazil",
-	"89259,State of Santa Catarina,Brazil",
-	"89260,State of Santa Catarina,Brazil",
-	"89261,State of Santa Catarina,Brazil",
-	"89262,State of Santa Catarina,Brazil",
-	"89264,State of Santa Catarina,Brazil",
-	"89265,State of Santa Catarina,Brazil",
-	"89266,State of Santa Catarina,Brazil",
-	"89280,State of Santa Catarina,Brazil",
-	"89281,State of Santa Catarina,Brazil",
-	"89282,State of Santa Catarina,Brazil",
-	"89283,State of Santa Catarina,Brazil",
-	"89285,State of Santa Catarina,Brazil",
-	"89286,State of Santa Catarina,Brazil",
-	"89287,State of Santa Catarina,Brazil",
-	"89288,State of Santa Catarina,Brazil",
-	"89289,State of Santa Catarina,Brazil",
-	"89290,State of Santa Catarina,Brazil",
-	"89291,State of Santa Catarina,Brazil",
-	"89292,State of Santa Catarina,Brazil",
-	"89802,State of Santa Catarina,Brazil",
-	"89804,State of Santa Catarina,Brazil",
-	"89805,State of Santa Catarina,Brazil",
-	"89806,State of Santa Catarina,Brazil",
-	"89809,State of Santa Catarina,Brazil",
-	"89810,State of Santa Catarina,Brazil",
-	"89812,State of Santa Catarina,Brazil",
-	"89814,State of Santa Catarina,Brazil",
-	"89816,State of Santa Catarina,Brazil",
-	"90200,State of Rio Grande do Sul,Brazil",
-	"91060,State of Rio Grande do Sul,Brazil",
-	"91120,State of Rio Grande do Sul,Brazil",
-	"91140,State of Rio Grande do Sul,Brazil",
-	"91440,State of Rio Grande do Sul,Brazil",
-	"91520,State of Rio Grande do Sul,Brazil",
-	"91540,State of Rio Grande do Sul,Brazil",
-	"91550,State of Rio Grande do Sul,Brazil",
-	"91560,State of Rio Grande do Sul,Brazil",
-	"91710,State of Rio Grande do Sul,Brazil",
-	"91712,State of Rio Grande do Sul,Brazil",
-	"91720,State of Rio Grande do Sul,Brazil",
-	"91740,State of Rio Grande do Sul,Brazil",
-	"91750,State of Rio Grande do Sul,Brazil",
-	"91755,State of Rio Grande do Sul,Brazil",
-	"91760,State of Rio Grande do Sul,Brazil",
-	"91770,State of Rio Grande do Sul,Brazil",
-	"91778,State of Rio Grande do Sul,Brazil",
-	"91780,State of Rio Grande do Sul,Brazil",
-	"91785,State of Rio Grande do Sul,Brazil",
-	"91787,State of Rio Grande do Sul,Brazil",
-	"91791,State of Rio Grande do Sul,Brazil",
-	"91793,State of Rio Grande do Sul,Brazil",
-	"91795,State of Rio Grande do Sul,Brazil",
-	"91796,State of Rio Grande do Sul,Brazil",
-	"91910,State of Rio Grande do Sul,Brazil",
-	"92010,State of Rio Grande do Sul,Brazil",
-	"92027,State of Rio Grande do Sul,Brazil",
-	"92035,State of Rio Grande do Sul,Brazil",
-	"92130,State of Rio Grande do Sul,Brazil",
-	"92200,State of Rio Grande do Sul,Brazil",
-	"92323,State of Rio Grande do Sul,Brazil",
-	"92325,State of Rio Grande do Sul,Brazil",
-	"92330,State of Rio Grande do Sul,Brazil",
-	"92410,State of Rio Grande do Sul,Brazil",
-	"92420,State of Rio Grande do Sul,Brazil",
-	"92425,State of Rio Grande do Sul,Brazil",
-	"92440,State of Rio Grande do Sul,Brazil",
-	"92441,State of Rio Grande do Sul,Brazil",
-	"93010,State of Rio Grande do Sul,Brazil",
-	"93022,State of Rio Grande do Sul,Brazil",
-	"93025,State of Rio Grande do Sul,Brazil",
-	"93032,State of Rio Grande do Sul,Brazil",
-	"93035,State of Rio Grande do Sul,Brazil",
-	"93125,State of Rio Grande do Sul,Brazil",
-	"93140,State of Rio Grande do Sul,Brazil",
-	"93145,State of Rio Grande do Sul,Brazil",
-	"93211,State of Rio Grande do Sul,Brazil",
-	"93224,State of Rio Grande do Sul,Brazil",
-	"93225,State of Rio Grande do Sul,Brazil",
-	"93230,State of Rio Grande do Sul,Brazil",
-	"93270,State of Rio Grande do Sul,Brazil",
-	"93347,State of Rio Grande do Sul,Brazil",
-	"93352,State of Rio Grande do Sul,Brazil",
-	"93415,State of Rio Grande do Sul,Brazil",
-	"93530,State of Rio Grande do Sul,Brazil",
-	"93700,State of Rio Grande do Sul,Brazil",
-	"94015,State of Rio Grande do Sul,Brazil",
-	"94100,State of Rio Grande do Sul,Brazil",
-	"94170,State of Rio Grande do Sul,Brazil",
-	"94175,State of Rio Grande do Sul,Brazil",
-	"94180,State of Rio Grande do Sul,Brazil",
-	"94185,State of Rio Grande do Sul,Brazil",
-	"94195,State of Rio Grande do Sul,Brazil",
-	"94197,State of Rio Grande do Sul,Brazil",
-	"94198,State of Rio Grande do Sul,Brazil",
-	"94199,State of Rio Grande do Sul,Brazil",
-	"94370,State of Rio Grande do Sul,Brazil",
-	"94416,State of Rio Grande do Sul,Brazil",
-	"94420,State of Rio Grande do Sul,Brazil",
-	"94426,State of Rio Grande do Sul,Brazil",
-	"94430,State of Rio Grande do Sul,Brazil",
-	"94435,State of Rio Grande do Sul,Brazil",
-	"94440,State of Rio Grande do Sul,Brazil",
-	"94445,State of Rio Grande do Sul,Brazil",
-	"94450,State of Rio Grande do Sul,Brazil",
-	"94455,State of Rio Grande do Sul,Brazil",
-	"94458,State of Rio Grande do Sul,Brazil",
-	"94460,State of Rio Grande do Sul,Brazil",
-	"94470,State of Rio Grande do Sul,Brazil",
-	"94475,State of Rio Grande do Sul,Brazil",
-	"94505,State of Rio Grande do Sul,Brazil",
-	"94515,State of Rio Grande do Sul,Brazil",
-	"94740,State of Rio Grande do Sul,Brazil",
-	"94818,State of Rio Grande do Sul,Brazil",
-	"94935,State of Rio Grande do Sul,Brazil",
-	"94940,State of Rio Grande do Sul,Brazil",
-	"94960,State of Rio Grande do Sul,Brazil",
-	"94965,State of Rio Grande do Sul,Brazil",
-	"94970,State of Rio Grande do Sul,Brazil",
-	"95012,State of Rio Grande do Sul,Brazil",
-	"95030,State of Rio Grande do Sul,Brazil",
-	"95032,State of Rio Grande do Sul,Brazil",
-	"95042,State of Rio Grande do Sul,Brazil",
-	"95044,State of Rio Grande do Sul,Brazil",
-	"95045,State of Rio Grande do Sul,Brazil",
-	"95047,State of Rio Grande do Sul,Brazil",
-	"95052,State of Rio Grande do Sul,Brazil",
-	"95055,State of Rio Grande do Sul,Brazil",
-	"95057,State of Rio Grande do Sul,Brazil",
-	"95058,State of Rio Grande do Sul,Brazil",
-	"95059,State of Rio Grande do Sul,Brazil",
-	"95060,State of Rio Grande do Sul,Brazil",
-	"95074,State of Rio Grande do Sul,Brazil",
-	"95076,State of Rio Grande do Sul,Brazil",
-	"95088,State of Rio Grande do Sul,Brazil",
-	"95090,State of Rio Grande do Sul,Brazil",
-	"95095,State of Rio Grande do Sul,Brazil",
-	"95096,State of Rio Grande do Sul,Brazil",
-	"95110,State of Rio Grande do Sul,Brazil",
-	"95112,State of Rio Grande do Sul,Brazil",
-	"95115,State of Rio Grande do Sul,Brazil",
-	"96015,State of Rio Grande do Sul,Brazil",
-	"96025,State of Rio Grande do Sul,Brazil",
-	"96030,State of Rio Grande do Sul,Brazil",
-	"96040,State of Rio Grande do Sul,Brazil",
-	"96045,State of Rio Grande do Sul,Brazil",
-	"96050,State of Rio Grande do Sul,Brazil",
-	"96055,State of Rio Grande do Sul,Brazil",
-	"96060,State of Rio Grande do Sul,Brazil",
-	"96065,State of Rio Grande do Sul,Brazil",
-	"96070,State of Rio Grande do Sul,Brazil",
-	"96072,State of Rio Grande do Sul,Brazil",
-	"96076,State of Rio Grande do Sul,Brazil",
-	"96083,State of Rio Grande do Sul,Brazil",
-	"96085,State of Rio Grande do Sul,Brazil",
-	"96087,State of Rio Grande do Sul,Brazil",
-	"96090,State of Rio Grande do Sul,Brazil",
-	"96203,State of Rio Grande do Sul,Brazil",
-	"96211,State of Rio Grande do Sul,Brazil",
-	"96212,State of Rio Grande do Sul,Brazil",
-	"96213,State of Rio Grande do Sul,Brazil",
-	"96214,State of Rio Grande do Sul,Brazil",
-	"96215,State of Rio Grande do Sul,Brazil",
-	"96400,State of Rio Grande do Sul,Brazil",
-	"96402,State of Rio Grande do Sul,Brazil",
-	"96410,State of Rio Grande do Sul,Brazil",
-	"96412,State of Rio Grande do Sul,Brazil",
-	"96413,State of Rio Grande do Sul,Brazil",
-	"96415,State of Rio Grande do Sul,Brazil",
-	"96417,State of Rio Grande do Sul,Brazil",
-	"96425,State of Rio Grande do Sul,Brazil",
-	"96501,State of Rio Grande do Sul,Brazil",
-	"96504,State of Rio Grande do Sul,Brazil",
-	"96505,State of Rio Grande do Sul,Brazil",
-	"96506,State of Rio Grande do Sul,Brazil",
-	"96820,State of Rio Grande do Sul,Brazil",
-	"96825,State of Rio Grande do Sul,Brazil",
-	"96830,State of Rio Grande do Sul,Brazil",
-	"96832,State of Rio Grande do Sul,Brazil",
-	"96835,State of Rio Grande do Sul,Brazil",
-	"96840,State of Rio Grande do Sul,Brazil",
-	"97021,State of Rio Grande do Sul,Brazil",
-	"97032,State of Rio Grande do Sul,Brazil",
-	"97035,State of Rio Grande do Sul,Brazil",
-	"97037,State of Rio Grande do Sul,Brazil",
-	"97040,State of Rio Grande do Sul,Brazil",
-	"97043,State of Rio Grande do Sul,Brazil",
-	"97045,State of Rio Grande do Sul,Brazil",
-	"97050,State of Rio Grande do Sul,Brazil",
-	"97065,State of Rio Grande do Sul,Brazil",
-	"97070,State of Rio Grande do Sul,Brazil",
-	"97090,State of Rio Grande do Sul,Brazil",
-	"97095,State of Rio Grande do Sul,Brazil",
-	"97105,State of Rio Grande do Sul,Brazil",
-	"97110,State of Rio Grande do Sul,Brazil",
-	"97501,State of Rio Grande do Sul,Brazil",
-	"97510,State of Rio Grande do Sul,Brazil",
-	"97535,State of Rio Grande do Sul,Brazil",
-	"97541,State of Rio Grande do Sul,Brazil",
-	"97542,State of Rio Grande do Sul,Brazil",
-	"97543,State of Rio Grande do Sul,Brazil",
-	"97546,State of Rio Grande do Sul,Brazil",
-	"97571,State of Rio Grande do Sul,Brazil",
-	"97572,State of Rio Grande do Sul,Brazil",
-	"97575,State of Rio Grande do Sul,Brazil",
-	"97576,State of Rio Grande do Sul,Brazil",
-	"97577,State of Rio Grande do Sul,Brazil",
-	"98005,State of Rio Grande do Sul,Brazil",
-	"98010,State of Rio Grande do Sul,Brazil",
-	"98015,State of Rio Grande do Sul,Brazil",
-	"98030,State of Rio Grande do Sul,Brazil",
-	"98045,State of Rio Grande do Sul,Brazil",
-	"98801,State of Rio Grande do Sul,Brazil",
-	"98807,State of Rio Grande do Sul,Brazil",
-	"99020,State of Rio Grande do Sul,Brazil",
-	"99025,State of Rio Grande do Sul,Brazil",
-	"99032,State of Rio Grande do Sul,Brazil",
-	"99040,State of Rio Grande do Sul,Brazil",
-	"99051,State of Rio Grande do Sul,Brazil",
-	"99054,State of Rio Grande do Sul,Brazil",
-	"99064,State of Rio Grande do Sul,Brazil",
-	"99066,State of Rio Grande do Sul,Brazil",
-	"99070,State of Rio Grande do Sul,Brazil",
-	"99072,State of Rio Grande do Sul,Brazil",
-	"35162,State of Minas Gerais,Brazil",
-	"Acu,State of Rio Grande do Norte,Brazil",
-	"Horizonte,Ceara,Brazil",
-	"Pacajus,Ceara,Brazil",
-	"Aracati,Aracati,Ceara,Brazil",
-	"Aquiraz,Aquiraz,Ceara,Brazil",
-	"Ceara,Brazil",
-	"Sao Benedito,Ceara,Brazil",
-	"Mauriti,Ceara,Brazil",
-	"Brejo Santo,Ceara,Brazil",
-	"Aeroporto Internacional do Recife/Guararapes - Gilberto Freyre,State of Pernambuco,Brazil",
-	"Guarulhos International Airport (GRU),State of Sao Paulo,Brazil",
-	"Pinto Martins – Fortaleza International Airport,Ceara,Brazil",
-	"Sorocaba Airport,State of Sao Paulo,Brazil",
-	"Tiangua,Ceara,Brazil",
-	"Quixeramobim,Ceara,Brazil",
-	"Quixada,Ceara,Brazil",
-	"Pacatuba,Ceara,Brazil",
-	"Morada Nova,Ceara,Brazil",
-	"Maranguape,Ceara,Brazil",
-	"Maracanau,Ceara,Brazil",
-	"Itapipoca,Ceara,Brazil",
-	"Itaitinga,Ceara,Brazil",
-	"Iguatu,Ceara,Brazil",
-	"Ico,Ceara,Brazil",
-	"Guaiuba,Ceara,Brazil",
-	"Eusebio,Ceara,Brazil",
-	"Crato,Ceara,Brazil",
-	"Crateus,Ceara,Brazil",
-	"Caucaia,Ceara,Brazil",
-	"Biritiba Mirim,State of Sao Paulo,Brazil",
-	"Barbalha,Ceara,Brazil",
-	"Aracati,Ceara,Brazil",
-	"Aquiraz,Ceara,Brazil",
-	"Sobral,Ceara,Brazil",
-	"Juazeiro do Norte,Ceara,Brazil",
-	"Fortaleza,Ceara,Brazil",
-	"Caninde,Ceara,Brazil",
-	"Reriutaba,Ceara,Brazil",
-	"Cascavel,Ceara,Brazil",
-	"Maranguape,Maranguape,Ceara,Brazil",
-	"Limoeiro do Norte,Ceara,Brazil",
-	"Russas,Ceara,Brazil",
-	"Acarau,Ceara,Brazil",
-	"Aeroporto Internacional Afonso Pena - Curitiba,State of Parana,Brazil",
-	"Sant'Ana do Livramento,State of Rio Grande do Sul,Brazil",
-	"Santa Izabel do Para,State of Para,Brazil",
-	"RIOgaleao - Aeroporto Internacional Tom Jobim,State of Rio de Janeiro,Brazil",
-	"Anil,State of Rio de Janeiro,Brazil",
-	"Lake Pampulha,State of Minas Gerais,Brazil",
-	"Fazendinha,State of Parana,Brazil",
-	"Jardim Carvalho,State of Rio Grande do Sul,Brazil",
-	"Estancia Velha,Estancia Velha,State of Rio Grande do Sul,Brazil",
-	"Deodoro,State of Rio de Janeiro,Brazil",
-	"Vila Militar,State of Rio de Janeiro,Brazil",
-	"Santa Teresa,State of Rio de Janeiro,Brazil",
-	"Parque Taquaral,State of Sao Paulo,Brazil",
-	"Itapira,Itapira,State of Sao Paulo,Brazil",
-	"Riviera de Sao Lourenco,State of Sao Paulo,Brazil",
-	"Campos do Jordao,Campos do Jordao,State of Sao Paulo,Brazil",
-	"Cidade Tiradentes,State of Sao Paulo,Brazil",
-	"Sao Rafael,State of Sao Paulo,Brazil",
-	"Aparecida,Aparecida,State of Sao Paulo,Brazil",
-	"Cidade Ademar,State of Sao Paulo,Brazil",
-	"Fazenda,State of Santa Catarina,Brazil",
-	"Itaborai,Itaborai,State of Rio de Janeiro,Brazil",
-	"Araraquara,Araraquara,State of Sao Paulo,Brazil",
-	"Jardim Paulista,State of Sao Paulo,Brazil",
-	"Lajeado,State of Sao Paulo,Brazil",
-	"Mandaqui,State of Sao Paulo,Brazil",
-	"Vila Maria,State of Sao Paulo,Brazil",
-	"Jardim Morada do Sol,State of Sao Paulo,Brazil",
-	"Campo Grande,State of Sao Paulo,Brazil",
-	"Caruaru,Caruaru,State of Pernambuco,Brazil",
-	"Lapa,State of Sao Paulo,Brazil",
-	"Sertaozinho,Sertaozinho,State of Sao Paulo,Brazil",
-	"Campeche,State of Santa Catarina,Brazil",
-	"Morumbi,State of Sao Paulo,Brazil",
-	"Paracatu,Paracatu,State of Minas Gerais,Brazil",
-	"Tucuruvi,State of Sao Paulo,Brazil",
-	"Petrolina,Petrolina,State of Pernambuco,Brazil",
-	"Campo Belo,State of Sao Paulo,Brazil",
-	"Araras,Araras,State of Sao Paulo,Brazil",
-	"Cuiaba,Cuiaba,State of Mato Grosso,Brazil",
-	"Guaianases,State of Sao Paulo,Brazil",
-	"Marilia,Marilia,State of Sao Paulo,Brazil",
-	"Pinheiros,State of Sao Paulo,Brazil",
-	"Vila Dirce,State of Sao Paulo,Brazil",
-	"Raposo Tavares,State of Sao Paulo,Brazil",
-	"Santa Maria,Federal District,Brazil",
-	"Vila Sonia,State of Sao Paulo,Brazil",
-	"Itaim Bibi,State of Sao Paulo,Brazil",
-	"Abrantes,State of Bahia,Brazil",
-	"Abreu e Lima,Abreu e Lima,State of Pernambuco,Brazil",
-	"Praia Grande,Praia Grande,State of Sao Paulo,Brazil",
-	"Sao Lucas,State of Sao Paulo,Brazil",
-	"Itapetininga,Itapetininga,State of Sao Paulo,Brazil",
-	"Santa Barbara d'Oeste,Santa Barbara d'Oeste,State of Sao Paulo,Brazil",
-	"Jardim Presidente Dutra,State of Sao Paulo,Brazil",
-	"Santana,State of Sao Paulo,Brazil",
-	"Sao Joao de Meriti,Sao Joao de Meriti,State of Rio de Janeiro,Brazil",
-	"Cursino,State of Sao Paulo,Brazil",
-	"Bombinhas,Bombinhas,State of Santa Catarina,Brazil",
-	"Santa Monica,State of Minas Gerais,Brazil",
-	"Barra Funda,State of Sao Paulo,Brazil",
-	"Tambore,State of Sao Paulo,Brazil",
-	"Neves,State of Rio de Janeiro,Brazil",
-	"Messejana,Ceara,Brazil",
-	"Pedreira,Pedreira,State of Sao Paulo,Brazil",
-	"Capim Macio,State of Rio Grande do Norte,Brazil",
-	"Sao Miguel Paulista,State of Sao Paulo,Brazil",
-	"Sao Lourenco,Sao Lourenco,State of Minas Gerais,Brazil",
-	"Joacaba,Joacaba,State of Santa Catarina,Brazil",
-	"Muriae,Muriae,State of Minas Gerais,Brazil",
-	"Ferraz de Vasconcelos,Ferraz de Vasconcelos,State of Sao Paulo,Brazil",
-	"Perdizes,State of Sao Paulo,Brazil",
-	"America,State of Santa Catarina,Brazil",
-	"Varginha,Varginha,State of Minas Gerais,Brazil",
-	"Carapina,State of Espirito Santo,Brazil",
-	"Leste,State of Minas Gerais,Brazil",
-	"Santa Maria,Santa Maria,State of Rio Grande do Sul,Brazil",
-	"Alvorada,Alvorada,State of Rio Grande do Sul,Brazil",
-	"Candeias,State of Pernambuco,Brazil",
-	"Guaratingueta,Guaratingueta,State of Sao Paulo,Brazil",
-	"Mooca,State of Sao Paulo,Brazil",
-	"Lins,Lins,State of Sao Paulo,Brazil",
-	"Barreiro,State of Minas Gerais,Brazil",
-	"Botucatu,Botucatu,State of Sao Paulo,Brazil",
-	"Vila Andrade,State of Sao Paulo,Brazil",
-	"Duque de Caxias,Duque de Caxias,State of Rio de Janeiro,Brazil",
-	"Justinopolis,State of Minas Gerais,Brazil",
-	"Pelotas,Pelotas,State of Rio Grande do Sul,Brazil",
-	"Morretes,State of Santa Catarina,Brazil",
-	"Mongagua,Mongagua,State of Sao Paulo,Brazil",
-	"Pocos de Caldas,Pocos de Caldas,State of Minas Gerais,Brazil",
-	"Indaiatuba,Indaiatuba,State of Sao Paulo,Brazil",
-	"Usiminas,State of Minas Gerais,Brazil",
-	"Vila Curuca,State of Sao Paulo,Brazil",
-	"Regiao Oceanica de Niteroi,State of Rio de Janeiro,Brazil",
-	"Campo Limpo,State of Sao Paulo,Brazil",
-	"Jardim Chapadao,State of Sao Paulo,Brazil",
-	"Campo Largo,Campo Largo,State of Parana,Brazil",
-	"Santo Amaro,State of Sao Paulo,Brazil",
-	"Setor Bueno,State of Goias,Brazil",
-	"Sapopemba,State of Sao Paulo,Brazil",
-	"Capao da Canoa,Capao da Canoa,State of Rio Grande do Sul,Brazil",
-	"Vila Emil,State of Rio de Janeiro,Brazil",
-	"Sao Domingos,State of Sao Paulo,Brazil",
-	"Boa Vista Paulitsa,State of Sao Paulo,Brazil",
-	"Barra Velha,Barra Velha,State of Santa Catarina,Brazil",
-	"Parangaba,Ceara,Brazil",
-	"North Wing,Federal District,Brazil",
-	"Capuava,State of Sao Paulo,Brazil",
-	"Suzano,Suzano,State of Sao Paulo,Brazil",
-	"Nova Brasilia,State of Goias,Brazil",
-	"Vitoria de Santo Antao,Vitoria de Santo Antao,State of Pernambuco,Brazil",
-	"Cidade Lider,State of Sao Paulo,Brazil",
-	"Jaragua,State of Sao Paulo,Brazil",
-	"Casa Verde,State of Sao Paulo,Brazil",
-	"Vila Velha,Vila Velha,State of Espirito Santo,Brazil",
-	"Aldeia,State of Sao Paulo,Brazil",
-	"Paulista,Paulista,State of Pernambuco,Brazil",
-	"Vila Matilde,State of Sao Paulo,Brazil",
-	"Maceio,Maceio,State of Alagoas,Brazil",
-	"Nova Russia,State of Parana,Brazil",
-	"Planaltina,Federal District,Brazil",
-	"Itaquari,State of Espirito Santo,Brazil",
-	"Macapa,Macapa,State of Amapa,Brazil",
-	"Penha,State of Sao Paulo,Brazil",
-	"Centro-Sul,State of Minas Gerais,Brazil",
-	"Ressacada,State of Santa Catarina,Brazil",
-	"Centro,State of Amazonas,Brazil",
-	"Gravatai,Gravatai,State of Rio Grande do Sul,Brazil",
-	"Centro,State of Santa Catarina,Brazil",
-	"Itaim Paulista,State of Sao Paulo,Brazil",
-	"Sitio do Campo,State of Sao Paulo,Brazil",
-	"Vila Medeiros,State of Sao Paulo,Brazil",
-	"Petropolis,Petropolis,State of Rio de Janeiro,Brazil",
-	"Capao Redondo,State of Sao Paulo,Brazil",
-	"Sao Vicente,State of Santa Catarina,Brazil",
-	"Rio Calro,State of Sao Paulo,Brazil",
-	"Barreiros,State of Santa Catarina,Brazil",
-	"Cidade Dutra,State of Sao Paulo,Brazil",
-	"Lages,Lages,State of Santa Catarina,Brazil",
-	"Itajai,Itajai,State of Santa Catarina,Brazil",
-	"Parque Industrial,State of Minas Gerais,Brazil",
-	"Velha,State of Santa Catarina,Brazil",
-	"Barao Geraldo,State of Sao Paulo,Brazil",
-	"Bom Retiro,State of Sao Paulo,Brazil",
-	"Vila Mariana,State of Sao Paulo,Brazil",
-	"Anapolis,Anapolis,State of Goias,Brazil",
-	"Rio Pequeno,State of Sao Paulo,Brazil",
-	"Ponta Grossa,Ponta Grossa,State of Parana,Brazil",
-	"Ribeirao Pires,Ribeirao Pires,State of Sao Paulo,Brazil",
-	"Nova Veneza,State of Sao Paulo,Brazil",
-	"Florianopolis,Florianopolis,State of Santa Catarina,Brazil",
-	"Pirituba,State of Sao Paulo,Brazil",
-	"Jacana,State of Sao Paulo,Brazil",
-	"Jabaquara,State of Sao Paulo,Brazil",
-	"Grajau,State of Sao Paulo,Brazil",
-	"Maringa,Maringa,State of Parana,Brazil",
-	"Americana,Americana,State of Sao Paulo,Brazil",
-	"Lagoa Nova,State of Rio Grande do Norte,Brazil",
-	"Vargem Grande Paulista,Vargem Grande Paulista,State of Sao Paulo,Brazil",
-	"Tijucas,Tijucas,State of Santa Catarina,Brazil",
-	"Ourinhos,Ourinhos,State of Sao Paulo,Brazil",
-	"Saude,State of Sao Paulo,Brazil",
-	"Vila Prudente,State of Sao Paulo,Brazil",
-	"Tatuape,State of Sao Paulo,Brazil",
-	"Asa Sul,Federal District,Brazil",
-	"Sao Joao da Boa Vista,Sao Joao da Boa Vista,State of Sao Paulo,Brazil",
-	"Ipiranga,State of Sao Paulo,Brazil",
-	"Mariana,Mariana,State of Minas Gerais,Brazil",
-	"Agua Rasa,State of Sao Paulo,Brazil",
-	"Mondubim,Ceara,Brazil",
-	"Ibes,State of Espirito Santo,Brazil",
-	"Fortaleza,Fortaleza,Ceara,Brazil",
-	"Consolacao,State of Sao Paulo,Brazil",
-	"Freguesia do O,State of Sao Paulo,Brazil",
-	"Polvilho,State of Sao Paulo,Brazil",
-	"Alto de Pinheiros,State of Sao Paulo,Brazil",
-	"Salto,Salto,State of Sao Paulo,Brazil",
-	"Aracatuba,Aracatuba,State of Sao Paulo,Brazil",
-	"Sao Mateus,State of Sao Paulo,Brazil",
-	"Engenho de Dentro,State of Rio de Janeiro,Brazil",
-	"Itauna,Itauna,State of Minas Gerais,Brazil",
-	"Petropolis,State of Rio Grande do Sul,Brazil",
-	"Alto da Boa Vista,State of Rio de Janeiro,Brazil",
-	"Nilopolis,Nilopolis,State of Rio de Janeiro,Brazil",
-	"Santo Antonio de Jesus,Santo Antonio de Jesus,State of Bahia,Brazil",
-	"Uberlandia,Uberlandia,State of Minas Gerais,Brazil",
-	"Santos,Santos,State of Sao Paulo,Brazil",
-	"Barretos,Barretos,State of Sao Paulo,Brazil",
-	"Assis,Assis,State of Sao Paulo,Brazil",
-	"Santa Candida,State of Parana,Brazil",
-	"Cubatao,Cubatao,State of Sao Paulo,Brazil",
-	"Catanduva,Catanduva,State of Sao Paulo,Brazil",
-	"Novo Mundo,State of Parana,Brazil",
-	"Lencois Paulista,Lencois Paulista,State of Sao Paulo,Brazil",
-	"Campos dos Goytacazes,Campos dos Goytacazes,State of Rio de Janeiro,Brazil",
-	"Arapongas,Arapongas,State of Parana,Brazil",
-	"Mare,State of Rio de Janeiro,Brazil",
-	"Rio de Janeiro,Rio de Janeiro,State of Rio de Janeiro,Brazil",
-	"Cavalhada,State of Rio Grande do Sul,Brazil",
-	"Ribeirao Preto,Ribeirao Preto,State of Sao Paulo,Brazil",
-	"Buritis,State of Minas Gerais,Brazil",
-	"Sao Jose do Rio Preto,Sao Jose do Rio Preto,State of Sao Paulo,Brazil",
-	"Tres Rios,Tres Rios,State of Rio de Janeiro,Brazil",
-	"Paulinia,Paulinia,State of Sao Paulo,Brazil",
-	"Salvador,Salvador,State of Bahia,Brazil",
-	"Patos de Minas,Patos de Minas,State of Minas Gerais,Brazil",
-	"Sao Carlos,Sao Carlos,State of Sao Paulo,Brazil",
-	"Aracaju,Aracaju,State of Sergipe,Brazil",
-	"Guarapuava,Guarapuava,State of Parana,Brazil",
-	"Itajuba,Itajuba,State of Minas Gerais,Brazil",
-	"Macae,Macae,State of Rio de Janeiro,Brazil",
-	"Cacapava,Cacapava,State of Sao Paulo,Brazil",
-	"Passos,Passos,State of Minas Gerais,Brazil",
-	"Aguas Claras, Federal District,Federal District,Brazil",
-	"Belford Roxo,Belford Roxo,State of Rio de Janeiro,Brazil",
-	"Jardim Guanabara,State of Rio de Janeiro,Brazil",
-	"Brasilia,Brasilia,Federal District,Brazil",
-	"Centro,State of Rio de Janeiro,Brazil",
-	"Lajeado,Lajeado,State of Rio Grande do Sul,Brazil",
-	"Madureira,State of Rio de Janeiro,Brazil",
-	"Pinheiros,Pinheiros,State of Sao Paulo,Brazil",
-	"Padre Miguel,State of Rio de Janeiro,Brazil",
-	"Itabuna,Itabuna,State of Bahia,Brazil",
-	"Bertioga,Bertioga,State of Sao Paulo,Brazil",
-	"Itapema,Itapema,State of Santa Catarina,Brazil",
-	"Ibirite,Ibirite,State of Minas Gerais,Brazil",
-	"Birigui,Birigui,State of Sao Paulo,Brazil",
-	"Barra da Tijuca,State of Rio de Janeiro,Brazil",
-	"Vila Velha,Vila Velha,State of Espirito Santo,Brazil",
-	"Navegantes,Navegantes,State of Santa Catarina,Brazil",
-	"Maringa,Maringa,State of Parana,Brazil",
-	"Boa Viagem,State of Pernambuco,Brazil",
-	"Sumare,Sumare,State of Sao Paulo,Brazil",
-	"Santissimo,State of Rio de Janeiro,Brazil",
-	"Viamao,Viamao,State of Rio Grande do Sul,Brazil",
-	"Fazendinha/Portao,State of Parana,Brazil",
-	"Matriz,State of Parana,Brazil",
-	"Boqueirao,State of Parana,Brazil",
-	"Sao Cristovao,State of Rio de Janeiro,Brazil",
-	"Uberaba,Uberaba,State of Minas Gerais,Brazil",
-	"Freguesia,State of Rio de Janeiro,Brazil",
-	"Centro,State of Parana,Brazil",
-	"Lorena,Lorena,State of Sao Paulo,Brazil",
-	"Sapiranga,Sapiranga,State of Rio Grande do Sul,Brazil",
-	"Taguatinga, Federal District,Federal District,Brazil",
-	"Messejana,Messejana,Ceara,Brazil",
-	"Sarandi,Porto Alegre,State of Rio Grande do Sul,Brazil",
-	"Cajuru,State of Parana,Brazil",
-	"Sao Jose,Sao Jose,State of Santa Catarina,Brazil",
-	"Balneario Camboriu,Balneario Camboriu,State of Santa Catarina,Brazil",
-	"Realengo,State of Rio de Janeiro,Brazil",
-	"Uberaba,State of Parana,Brazil",
-	"Goiania,Goiania,State of Goias,Brazil",
-	"Belem,Belem,State of Para,Brazil",
-	"Esteio,Esteio,State of Rio Grande do Sul,Brazil",
-	"Botafogo,State of Rio de Janeiro,Brazil",
-	"Niteroi,Niteroi,State of Rio de Janeiro,Brazil",
-	"Butanta,State of Sao Paulo,Brazil",
-	"Cascavel,Cascavel,State of Parana,Brazil",
-	"Farroupilha,Farroupilha,State of Rio Grande do Sul,Brazil",
-	"Itacorubi,State of Santa Catarina,Brazil",
-	"Imperatriz,Imperatriz,State of Maranhao,Brazil",
-	"Queimados,Queimados,State of Rio de Janeiro,Brazil",
-	"Foz do Iguacu,Foz do Iguacu,State of Parana,Brazil",
-	"Florianopolis,Florianopolis,State of Santa Catarina,Brazil",
-	"Sao Sebastiao,Sao Sebastiao,State of Sao Paulo,Brazil",
-	"Mogi Guacu,Mogi Guacu,State of Sao Paulo,Brazil",
-	"Vila Isabel,State of Rio de Janeiro,Brazil",
-	"Presidente Prudente,Presidente Prudente,State of Sao Paulo,Brazil",
-	"Santa Cruz do Sul,Santa Cruz do Sul,State of Rio Grande do Sul,Brazil",
-	"Recreio dos Bandeirantes,State of Rio de Janeiro,Brazil",
-	"Leme,Leme,State of Sao Paulo,Brazil",
-	"Jaguare,State of Sao Paulo,Brazil",
-	"Para de Minas,Para de Minas,State of Minas Gerais,Brazil",
-	"Tramandai,Tramandai,State of Rio Grande do Sul,Brazil",
-	"Natal,Natal,State of Rio Grande do Norte,Brazil",
-	"Itanhaem,Itanhaem,State of Sao Paulo,Brazil",
-	"Cuiaba,Cuiaba,State of Mato Grosso,Brazil",
-	"Bacacheri,State of Parana,Brazil",
-	"Juazeiro do Norte,Juazeiro do Norte,Ceara,Brazil",
-	"Pato Branco,Pato Branco,State of Parana,Brazil",
-	"Piracicaba,Piracicaba,State of Sao Paulo,Brazil",
-	"Samambaia,Federal District,Brazil",
-	"Biguacu,Biguacu,State of Santa Catarina,Brazil",
-	"Osasco,Osasco,State of Sao Paulo,Brazil",
-	"Manaus,Manaus,State of Amazonas,Brazil",
-	"Nova Iguacu,Nova Iguacu,State of Rio de Janeiro,Brazil",
-	"Alfenas,Alfenas,State of Minas Gerais,Brazil",
-	"Jau,Jau,State of Sao Paulo,Brazil",
-	"Itaquera,State of Sao Paulo,Brazil",
-	"Mesquita,Mesquita,State of Rio de Janeiro,Brazil",
-	"Caxias do Sul,Caxias do Sul,State of Rio Grande do Sul,Brazil",
-	"Sobradinho,Federal District,Brazil",
-	"Cristal,State of Rio Grande do Sul,Brazil",
-	"Cabo Frio,Cabo Frio,State of Rio de Janeiro,Brazil",
-	"Sao Caetano do Sul,Sao Caetano do Sul,State of Sao Paulo,Brazil",
-	"Jaboatao dos Guararapes,State of Pernambuco,Brazil",
-	"Cotia,State of Sao Paulo,Brazil",
-	"Santo Andre,State of Sao Paulo,Brazil",
-	"Pedreira,State of Sao Paulo,Brazil",
-	"Carapicuiba,State of Sao Paulo,Brazil",
-	"Sao Caetano do Sul,State of Sao Paulo,Brazil",
-	"Vitoria,State of Espirito Santo,Brazil",
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// City is a Brazilian municipality that can be monitored.
+//
+// The IBGE data of the catalogue is partial: IBGECode, Population and the
+// coordinates are filled in for the state capitals only, and are zero on the
+// other cities until cities.csv is regenerated from the IBGE tables with
+// go generate, which needs network access.
+type City struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+	UF    string `json:"uf"`
+	// IBGECode is the 7-digit IBGE municipality code, 0 when not yet filled in.
+	IBGECode int `json:"ibge_code,omitempty"`
+	// Location is the canonical location string sent to SerpAPI.
+	Location   string  `json:"location"`
+	Population int     `json:"population,omitempty"`
+	Latitude   float64 `json:"latitude,omitempty"`
+	Longitude  float64 `json:"longitude,omitempty"`
+	Capital    bool    `json:"capital"`
+}
+
+//go:generate go run ../cmd/cities -catalogue cities.csv -out cities.csv
+
+//go:embed cities.csv
+var citiesCSV []byte
+
+var (
+	catalogue = mustLoad(citiesCSV)
+	byName    = indexByName(catalogue)
+)
+
+// aliases maps places the original city list offered that are not
+// municipalities to the city they belong to, keyed like byName.
+var aliases = map[string]string{
+	"messejana/CE": "Fortaleza",
+	"mondubim/CE":  "Fortaleza",
+	"parangaba/CE": "Fortaleza",
+	"pinto martins – fortaleza international airport/CE": "Fortaleza",
+}
+
+func mustLoad(data []byte) []City {
+	list, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		panic(fmt.Sprintf("cities: invalid embedded catalogue: %v", err))
+	}
+	if err := Validate(list); err != nil {
+		panic(fmt.Sprintf("cities: invalid embedded catalogue: %v", err))
+	}
+	return list
+}
+
+// GetCities returns the whole catalogue, ordered by state and name.
+func GetCities() []City {
+	return append([]City(nil), catalogue...)
 }
 
-func GetCities() []string {
-	return cities[:]
+// Filter returns the cities of uf (any state when empty) whose name contains
+// q, ignoring case and accents (any name when empty).
+func Filter(uf, q string) []City {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+	q = fold(strings.TrimSpace(q))
+
+	matches := []City{}
+	for _, city := range catalogue {
+		if uf != "" && city.UF != uf {
+			continue
+		}
+		if q != "" && !strings.Contains(fold(city.Name), q) {
+			continue
+		}
+		matches = append(matches, city)
+	}
+	return matches
+}
+
+func indexByName(list []City) map[string]int {
+	index := make(map[string]int, len(list))
+	for i, city := range list {
+		index[nameKey(city.Name, city.UF)] = i
+	}
+	return index
+}
+
+func nameKey(name, uf string) string {
+	return fold(strings.TrimSpace(name)) + "/" + uf
+}
+
+// Lookup finds a city by its SerpAPI location string (case-insensitive) or
+// by its IBGE code. Location strings in the formats of the original city
+// list, such as "Jau,Jau,State of Sao Paulo,Brazil" or "Crato,Ceara,Brazil",
+// are matched on the city name and state, ignoring case and accents.
+func Lookup(key string) (City, bool) {
+	key = strings.TrimSpace(key)
+
+	if code, err := strconv.Atoi(key); err == nil {
+		for _, city := range catalogue {
+			if city.IBGECode != 0 && city.IBGECode == code {
+				return city, true
+			}
+		}
+		return City{}, false
+	}
+
+	for _, city := range catalogue {
+		if strings.EqualFold(city.Location, key) {
+			return city, true
+		}
+	}
+	return lookupLegacy(key)
+}
+
+// lookupLegacy resolves "Name[,County],State[,Brazil]" by the first and the
+// state parts. The state may be its SerpAPI name, with or without "State
+// of", its full name or its abbreviation.
+func lookupLegacy(key string) (City, bool) {
+	parts := strings.Split(key, ",")
+	if len(parts) > 2 && fold(strings.TrimSpace(parts[len(parts)-1])) == "brazil" {
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) < 2 {
+		return City{}, false
+	}
+
+	uf, ok := ufOf(parts[len(parts)-1])
+	if !ok {
+		return City{}, false
+	}
+
+	name := nameKey(parts[0], uf)
+	if alias, ok := aliases[name]; ok {
+		name = nameKey(alias, uf)
+	}
+	if i, ok := byName[name]; ok {
+		return catalogue[i], true
+	}
+	return City{}, false
+}
+
+// ufOf returns the abbreviation of the state called name.
+func ufOf(name string) (string, bool) {
+	name = fold(strings.TrimSpace(name))
+	for uf, st := range states {
+		location := fold(st.Location)
+		if name == strings.ToLower(uf) || name == fold(st.Name) || name == location || name == strings.TrimPrefix(location, "state of ") {
+			return uf, true
+		}
+	}
+	return "", false
+}
+
+// Normalize resolves names with Lookup, drops duplicates (keeping the first
+// occurrence) and returns the SerpAPI location of each city. Names that are
+// not in the catalogue are returned in unknown.
+func Normalize(names []string) (canonical []string, unknown []string) {
+	seen := map[string]bool{}
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		city, ok := Lookup(name)
+		if !ok {
+			unknown = append(unknown, strings.TrimSpace(name))
+			continue
+		}
+		if seen[city.Location] {
+			continue
+		}
+		seen[city.Location] = true
+		canonical = append(canonical, city.Location)
+	}
+	return canonical, unknown
+}
+
+// ValidUF reports whether uf is a Brazilian state abbreviation.
+func ValidUF(uf string) bool {
+	_, ok := states[strings.ToUpper(uf)]
+	return ok
+}
+
+// States returns the state abbreviations in alphabetical order.
+func States() []string {
+	ufs := make([]string, 0, len(states))
+	for uf := range states {
+		ufs = append(ufs, uf)
+	}
+	sort.Strings(ufs)
+	return ufs
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "ê", "e", "è", "e", "ë", "e",
+	"í", "i", "î", "i", "ì", "i", "ï", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ò", "o", "ö", "o",
+	"ú", "u", "û", "u", "ù", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+func fold(s string) string {
+	return accents.Replace(strings.ToLower(s))
 }
@@ -0,0 +1,143 @@
+package cities
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedCatalogue(t *testing.T) {
+	list, err := Parse(strings.NewReader(string(citiesCSV)))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := Validate(list); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	// Only the capitals are declared complete; Validate checks that they
+	// have every IBGE column, so here it is enough to count them.
+	capitals := 0
+	for _, city := range list {
+		if city.Capital {
+			capitals++
+		}
+	}
+	if capitals != len(states) {
+		t.Errorf("got %d capitals, want one per state (%d)", capitals, len(states))
+	}
+}
+
+const header = "ibge_code,name,uf,location,population,latitude,longitude,capital\n"
+
+func TestValidateRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		rows string
+		want string
+	}{
+		{
+			name: "duplicate location",
+			rows: `,Jau,SP,"Jau,State of Sao Paulo,Brazil",,,,
+,Jaú,SP,"Jau,State of Sao Paulo,Brazil",,,,
+`,
+			want: "duplicate location",
+		},
+		{
+			name: "duplicate ibge code",
+			rows: `3550308,Sao Paulo,SP,"Sao Paulo,State of Sao Paulo,Brazil",11451999,-23.5329,-46.6395,true
+3550308,Campinas,SP,"Campinas,State of Sao Paulo,Brazil",1139047,-22.9053,-47.0659,
+`,
+			want: "duplicate ibge_code",
+		},
+		{
+			name: "unknown uf",
+			rows: `,Jau,XX,"Jau,State of Sao Paulo,Brazil",,,,
+`,
+			want: "unknown uf",
+		},
+		{
+			name: "empty name",
+			rows: `,,SP,",State of Sao Paulo,Brazil",,,,
+`,
+			want: "name is empty",
+		},
+		{
+			name: "coordinates outside Brazil",
+			rows: `3509502,Campinas,SP,"Campinas,State of Sao Paulo,Brazil",1139047,47.0659,22.9053,
+`,
+			want: "outside Brazil",
+		},
+		{
+			name: "partial ibge data",
+			rows: `3509502,Campinas,SP,"Campinas,State of Sao Paulo,Brazil",,,,
+`,
+			want: "filled in together",
+		},
+		{
+			name: "capital without ibge data",
+			rows: `,Sao Paulo,SP,"Sao Paulo,State of Sao Paulo,Brazil",,,,true
+`,
+			want: "capital without",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := Parse(strings.NewReader(header + tt.rows))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			err = Validate(list)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsMalformedCoordinates(t *testing.T) {
+	rows := `3509502,Campinas,SP,"Campinas,State of Sao Paulo,Brazil",1139047,-22.9053;-47.0659,,
+`
+	if _, err := Parse(strings.NewReader(header + rows)); err == nil || !strings.Contains(err.Error(), "latitude") {
+		t.Errorf("Parse error = %v, want a latitude error", err)
+	}
+}
+
+func TestMustLoadPanicsOnInvalidCatalogue(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("mustLoad did not panic on a duplicated city")
+		}
+	}()
+	mustLoad([]byte(header + `,Jau,SP,"Jau,State of Sao Paulo,Brazil",,,,
+,Jau,SP,"Jau,State of Sao Paulo,Brazil",,,,
+`))
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"Jau,State of Sao Paulo,Brazil", "Jau,State of Sao Paulo,Brazil"},
+		{"jau,state of sao paulo,brazil", "Jau,State of Sao Paulo,Brazil"},
+		{"3550308", "Sao Paulo,State of Sao Paulo,Brazil"},
+		// Formats of the original city list.
+		{"Jau,Jau,State of Sao Paulo,Brazil", "Jau,State of Sao Paulo,Brazil"},
+		{"Crato,Ceara,Brazil", "Crato,State of Ceara,Brazil"},
+		{"Fortaleza,Fortaleza,Ceara,Brazil", "Fortaleza,State of Ceara,Brazil"},
+		{"Messejana,Ceara,Brazil", "Fortaleza,State of Ceara,Brazil"},
+		{"Jaú, SP", "Jau,State of Sao Paulo,Brazil"},
+		{"Jau,State of Parana,Brazil", ""},
+		{"State of Sao Paulo,Brazil", ""},
+		{"04849,State of Sao Paulo,Brazil", ""},
+		{"9999999", ""},
+	}
+
+	for _, tt := range tests {
+		city, ok := Lookup(tt.key)
+		if ok != (tt.want != "") || city.Location != tt.want {
+			t.Errorf("Lookup(%q) = %q, %v; want %q", tt.key, city.Location, ok, tt.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package cities
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Municipality is a row of the IBGE municipality table: the code and name
+// from the localidades API, the 2022 census population and the centroid of
+// the municipality's boundary.
+type Municipality struct {
+	Code       int
+	Name       string
+	Population int
+	Latitude   float64
+	Longitude  float64
+}
+
+// Import fills in the IBGE code, population and coordinates of every city of
+// list from municipalities, matching them on name and state and ignoring
+// case, accents, hyphens and apostrophes. Cities without a municipality are
+// reported in the error; list is not changed.
+func Import(list []City, municipalities []Municipality) ([]City, error) {
+	ufs := map[int]string{}
+	for uf, st := range states {
+		ufs[st.Code] = uf
+	}
+	byKey := map[string]Municipality{}
+	for _, m := range municipalities {
+		uf, ok := ufs[m.Code/100000]
+		if !ok {
+			return nil, fmt.Errorf("municipality %s: code %d belongs to no state", m.Name, m.Code)
+		}
+		byKey[importKey(m.Name, uf)] = m
+	}
+
+	var errs []error
+	imported := make([]City, len(list))
+	for i, city := range list {
+		m, ok := byKey[importKey(city.Name, city.UF)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s (%s): not in the IBGE municipality table", city.Name, city.UF))
+			continue
+		}
+		city.IBGECode = m.Code
+		city.Population = m.Population
+		city.Latitude = m.Latitude
+		city.Longitude = m.Longitude
+		imported[i] = city
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
+var importSeparators = strings.NewReplacer("-", " ", "'", "", "’", "", "`", "")
+
+func importKey(name, uf string) string {
+	return strings.Join(strings.Fields(importSeparators.Replace(fold(name))), " ") + "/" + uf
+}
+
+// Complete reports every city of list that lacks its IBGE code, population
+// or coordinates. The embedded catalogue must pass it once regenerated from
+// the IBGE tables with go generate.
+func Complete(list []City) error {
+	var errs []error
+	for _, city := range list {
+		var missing []string
+		if city.IBGECode == 0 {
+			missing = append(missing, "ibge_code")
+		}
+		if city.Population == 0 {
+			missing = append(missing, "population")
+		}
+		if city.Latitude == 0 && city.Longitude == 0 {
+			missing = append(missing, "coordinates")
+		}
+		if len(missing) > 0 {
+			errs = append(errs, fmt.Errorf("%s (%s): no %s", city.Name, city.UF, strings.Join(missing, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Write writes list, in order, in the format of the embedded cities.csv.
+func Write(w io.Writer, list []City) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, city := range list {
+		record := []string{
+			optionalInt(city.IBGECode),
+			city.Name,
+			city.UF,
+			city.Location,
+			optionalInt(city.Population),
+			optionalFloat(city.Latitude),
+			optionalFloat(city.Longitude),
+			"",
+		}
+		if city.Capital {
+			record[7] = "true"
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func optionalInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func optionalFloat(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
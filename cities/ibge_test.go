@@ -0,0 +1,83 @@
+package cities
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestImport(t *testing.T) {
+	list, err := Parse(strings.NewReader(header + `,Jau,SP,"Jau,State of Sao Paulo,Brazil",,,,
+,Santa Barbara d'Oeste,SP,"Santa Barbara d'Oeste,State of Sao Paulo,Brazil",,,,
+,Ji-Parana,RO,"Ji-Parana,State of Rondonia,Brazil",,,,
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	municipalities := []Municipality{
+		{Code: 3525300, Name: "Jaú", Population: 133497, Latitude: -22.2936, Longitude: -48.5592},
+		{Code: 3545803, Name: "Santa Bárbara D’Oeste", Population: 183347, Latitude: -22.7553, Longitude: -47.4143},
+		{Code: 1100122, Name: "Ji-Paraná", Population: 124333, Latitude: -10.8777, Longitude: -61.9322},
+		// Same name as Jaú, in another state.
+		{Code: 4112900, Name: "Jau", Population: 1, Latitude: -1, Longitude: -50},
+	}
+
+	imported, err := Import(list, municipalities)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if err := Complete(imported); err != nil {
+		t.Errorf("Complete: %v", err)
+	}
+	if err := Validate(imported); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+	for i, want := range []int{3525300, 3545803, 1100122} {
+		if imported[i].IBGECode != want {
+			t.Errorf("%s: ibge_code = %d, want %d", imported[i].Name, imported[i].IBGECode, want)
+		}
+	}
+	if imported[0].Name != "Jau" || imported[0].Population != 133497 {
+		t.Errorf("Jau = %+v, want its name kept and the IBGE population", imported[0])
+	}
+	if list[0].IBGECode != 0 {
+		t.Error("Import changed its argument")
+	}
+
+	if _, err := Import(list, municipalities[:1]); err == nil || !strings.Contains(err.Error(), "Ji-Parana (RO): not in the IBGE municipality table") {
+		t.Errorf("Import = %v, want the missing cities reported", err)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	list, err := Parse(strings.NewReader(header + `3550308,Sao Paulo,SP,"Sao Paulo,State of Sao Paulo,Brazil",11451999,-23.5329,-46.6395,true
+,Jau,SP,"Jau,State of Sao Paulo,Brazil",,,,
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Complete(list)
+	if err == nil || !strings.Contains(err.Error(), "Jau (SP): no ibge_code, population, coordinates") {
+		t.Errorf("Complete = %v, want Jau reported", err)
+	}
+	if strings.Contains(err.Error(), "Sao Paulo (SP)") {
+		t.Errorf("Complete reported a complete city: %v", err)
+	}
+}
+
+func TestWriteRoundTrips(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, catalogue); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), citiesCSV) {
+		t.Error("writing the parsed catalogue does not reproduce cities.csv")
+	}
+}
+
+func TestEmbeddedCatalogueIsComplete(t *testing.T) {
+	if err := Complete(catalogue); err != nil {
+		missing := strings.Count(err.Error(), "\n") + 1
+		t.Skipf("%d of %d cities lack IBGE data; regenerate cities.csv with go generate ./cities", missing, len(catalogue))
+	}
+}
@@ -0,0 +1,94 @@
+package cities
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Preset names. Parameterised presets take their argument after a colon,
+// e.g. "uf:SP" or "top:50".
+const (
+	PresetCapitals = "capitals"
+	PresetUF       = "uf"
+	PresetTop      = "top"
+)
+
+// PresetInfo describes a built-in preset for listings.
+type PresetInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Presets lists the built-in presets.
+func Presets() []PresetInfo {
+	return []PresetInfo{
+		{Name: PresetCapitals, Description: "All state capitals"},
+		{Name: PresetUF + ":<UF>", Description: "All cities of a state, e.g. uf:SP"},
+		{Name: PresetTop + ":<N>", Description: "The N most populous cities with a known population, e.g. top:20; N may not exceed the cities with one"},
+	}
+}
+
+// IsPresetName reports whether name uses the preset namespace, whether or
+// not its argument is valid. Stored groups may not use these names.
+func IsPresetName(name string) bool {
+	kind, _, _ := strings.Cut(strings.ToLower(name), ":")
+	return kind == PresetCapitals || kind == PresetUF || kind == PresetTop
+}
+
+// Preset resolves a built-in preset name to its cities. ok is false when
+// name is not a preset; err is set when it is one with an invalid argument.
+func Preset(name string) (list []City, ok bool, err error) {
+	if !IsPresetName(name) {
+		return nil, false, nil
+	}
+
+	kind, arg, _ := strings.Cut(strings.ToLower(strings.TrimSpace(name)), ":")
+	switch kind {
+	case PresetCapitals:
+		for _, city := range catalogue {
+			if city.Capital {
+				list = append(list, city)
+			}
+		}
+		return list, true, nil
+
+	case PresetUF:
+		uf := strings.ToUpper(arg)
+		if !ValidUF(uf) {
+			return nil, true, fmt.Errorf("unknown uf %q", arg)
+		}
+		return Filter(uf, ""), true, nil
+
+	default: // PresetTop
+		n, err := strconv.Atoi(arg)
+		if err != nil || n <= 0 {
+			return nil, true, fmt.Errorf("invalid city count %q", arg)
+		}
+
+		for _, city := range catalogue {
+			if city.Population > 0 {
+				list = append(list, city)
+			}
+		}
+		// The population is not known for every city yet, so a larger N
+		// would quietly return fewer cities than asked for.
+		if n > len(list) {
+			return nil, true, fmt.Errorf("only %d cities have a known population, cannot pick the top %d", len(list), n)
+		}
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Population > list[j].Population
+		})
+		return list[:n], true, nil
+	}
+}
+
+// Locations returns the SerpAPI location of every city in list.
+func Locations(list []City) []string {
+	locations := make([]string, 0, len(list))
+	for _, city := range list {
+		locations = append(locations, city.Location)
+	}
+	return locations
+}
@@ -0,0 +1,96 @@
+package cities
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPresetTop(t *testing.T) {
+	list, ok, err := Preset("top:3")
+	if !ok || err != nil {
+		t.Fatalf("Preset(top:3) = %v, %v", ok, err)
+	}
+	if len(list) != 3 || list[0].Name != "Sao Paulo" {
+		t.Errorf("Preset(top:3) = %v, want 3 cities starting with Sao Paulo", Locations(list))
+	}
+	for i := 1; i < len(list); i++ {
+		if list[i].Population > list[i-1].Population {
+			t.Errorf("Preset(top:3) is not ordered by population: %v", Locations(list))
+		}
+	}
+
+	known := 0
+	for _, city := range catalogue {
+		if city.Population > 0 {
+			known++
+		}
+	}
+	if list, _, err := Preset(fmt.Sprintf("top:%d", known)); err != nil || len(list) != known {
+		t.Errorf("Preset(top:%d) = %d cities, %v; want every city with a population", known, len(list), err)
+	}
+	if _, _, err := Preset(fmt.Sprintf("top:%d", known+1)); err == nil {
+		t.Errorf("Preset(top:%d) succeeded although only %d cities have a population", known+1, known)
+	}
+}
+
+// withCatalogue replaces the catalogue with the cities of rows for the
+// duration of the test.
+func withCatalogue(t *testing.T, rows string) {
+	t.Helper()
+	saved, savedIndex := catalogue, byName
+	t.Cleanup(func() { catalogue, byName = saved, savedIndex })
+
+	catalogue = mustLoad([]byte(header + rows))
+	byName = indexByName(catalogue)
+}
+
+func TestPresetTopIncludesOtherCities(t *testing.T) {
+	withCatalogue(t, `3550308,Sao Paulo,SP,"Sao Paulo,State of Sao Paulo,Brazil",11451999,-23.5329,-46.6395,true
+3518800,Guarulhos,SP,"Guarulhos,State of Sao Paulo,Brazil",1291771,-23.4538,-46.5333,
+3509502,Campinas,SP,"Campinas,State of Sao Paulo,Brazil",1139047,-22.9053,-47.0659,
+3525300,Jau,SP,"Jau,State of Sao Paulo,Brazil",133497,-22.2936,-48.5592,
+1400100,Boa Vista,RR,"Boa Vista,State of Roraima,Brazil",413486,2.8235,-60.6758,true
+,Bauru,SP,"Bauru,State of Sao Paulo,Brazil",,,,
+`)
+
+	list, ok, err := Preset("top:4")
+	if !ok || err != nil {
+		t.Fatalf("Preset(top:4) = %v, %v", ok, err)
+	}
+	want := []string{"Sao Paulo", "Guarulhos", "Campinas", "Boa Vista"}
+	for i, city := range list {
+		if i >= len(want) || city.Name != want[i] {
+			t.Fatalf("Preset(top:4) = %v, want %v", Locations(list), want)
+		}
+	}
+	if len(list) != len(want) {
+		t.Fatalf("Preset(top:4) = %v, want %v", Locations(list), want)
+	}
+
+	if _, _, err := Preset("top:6"); err == nil {
+		t.Error("Preset(top:6) counted Bauru, which has no population")
+	}
+}
+
+// TestPresetTopOnCatalogue checks the ranking against the census once the
+// catalogue has every population; the largest cities that are not capitals
+// must then rank among the top 20.
+func TestPresetTopOnCatalogue(t *testing.T) {
+	if err := Complete(catalogue); err != nil {
+		t.Skip("cities.csv lacks IBGE data; regenerate it with go generate ./cities")
+	}
+
+	list, _, err := Preset("top:20")
+	if err != nil {
+		t.Fatalf("Preset(top:20): %v", err)
+	}
+	names := map[string]bool{}
+	for _, city := range list {
+		names[city.Name+"/"+city.UF] = true
+	}
+	for _, want := range []string{"Guarulhos/SP", "Campinas/SP"} {
+		if !names[want] {
+			t.Errorf("Preset(top:20) = %v, want %s", Locations(list), want)
+		}
+	}
+}
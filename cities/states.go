@@ -0,0 +1,39 @@
+package cities
+
+type state struct {
+	Name string
+	// Code is the 2-digit IBGE state code, the prefix of its municipality codes.
+	Code int
+	// Location is how SerpAPI names the state in location strings.
+	Location string
+}
+
+var states = map[string]state{
+	"AC": {Name: "Acre", Code: 12, Location: "State of Acre"},
+	"AL": {Name: "Alagoas", Code: 27, Location: "State of Alagoas"},
+	"AM": {Name: "Amazonas", Code: 13, Location: "State of Amazonas"},
+	"AP": {Name: "Amapá", Code: 16, Location: "State of Amapa"},
+	"BA": {Name: "Bahia", Code: 29, Location: "State of Bahia"},
+	"CE": {Name: "Ceará", Code: 23, Location: "State of Ceara"},
+	"DF": {Name: "Distrito Federal", Code: 53, Location: "Federal District"},
+	"ES": {Name: "Espírito Santo", Code: 32, Location: "State of Espirito Santo"},
+	"GO": {Name: "Goiás", Code: 52, Location: "State of Goias"},
+	"MA": {Name: "Maranhão", Code: 21, Location: "State of Maranhao"},
+	"MG": {Name: "Minas Gerais", Code: 31, Location: "State of Minas Gerais"},
+	"MS": {Name: "Mato Grosso do Sul", Code: 50, Location: "State of Mato Grosso do Sul"},
+	"MT": {Name: "Mato Grosso", Code: 51, Location: "State of Mato Grosso"},
+	"PA": {Name: "Pará", Code: 15, Location: "State of Para"},
+	"PB": {Name: "Paraíba", Code: 25, Location: "State of Paraiba"},
+	"PE": {Name: "Pernambuco", Code: 26, Location: "State of Pernambuco"},
+	"PI": {Name: "Piauí", Code: 22, Location: "State of Piaui"},
+	"PR": {Name: "Paraná", Code: 41, Location: "State of Parana"},
+	"RJ": {Name: "Rio de Janeiro", Code: 33, Location: "State of Rio de Janeiro"},
+	"RN": {Name: "Rio Grande do Norte", Code: 24, Location: "State of Rio Grande do Norte"},
+	"RO": {Name: "Rondônia", Code: 11, Location: "State of Rondonia"},
+	"RR": {Name: "Roraima", Code: 14, Location: "State of Roraima"},
+	"RS": {Name: "Rio Grande do Sul", Code: 43, Location: "State of Rio Grande do Sul"},
+	"SC": {Name: "Santa Catarina", Code: 42, Location: "State of Santa Catarina"},
+	"SE": {Name: "Sergipe", Code: 28, Location: "State of Sergipe"},
+	"SP": {Name: "São Paulo", Code: 35, Location: "State of Sao Paulo"},
+	"TO": {Name: "Tocantins", Code: 17, Location: "State of Tocantins"},
+}
@@ -0,0 +1,187 @@
+// Command cities regenerates the embedded city catalogue from the IBGE
+// tables: municipality codes from the localidades API, the 2022 census
+// population from SIDRA table 4709 and coordinates from the centroids of the
+// municipality boundaries.
+//
+//	go run ./cmd/cities [-catalogue cities/cities.csv] [-out cities/cities.csv]
+//
+// Names, SerpAPI locations and capitals are kept from the catalogue. The
+// command fails, writing nothing, unless every city is found in the IBGE
+// tables, so the catalogue never ends up partially filled in.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"google-monitoring/cities"
+)
+
+const (
+	municipalitiesURL = "https://servicodados.ibge.gov.br/api/v1/localidades/municipios"
+	populationURL     = "https://apisidra.ibge.gov.br/values/t/4709/n6/all/v/93/p/2022"
+	centroidURL       = "https://servicodados.ibge.gov.br/api/v3/malhas/municipios/%d/metadados"
+)
+
+var client = &http.Client{Timeout: time.Minute}
+
+func main() {
+	cataloguePath := flag.String("catalogue", "cities/cities.csv", "catalogue to take names, locations and capitals from")
+	out := flag.String("out", "cities/cities.csv", "file to write the regenerated catalogue to")
+	concurrency := flag.Int("concurrency", 8, "centroid requests to run at once")
+	flag.Parse()
+
+	data, err := os.ReadFile(*cataloguePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	list, err := cities.Parse(bytes.NewReader(data))
+	if err != nil {
+		log.Fatalf("Invalid catalogue %s: %v", *cataloguePath, err)
+	}
+	ctx := context.Background()
+
+	municipalities, err := fetchMunicipalities(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Centroids are fetched one municipality at a time, so only for the
+	// cities of the catalogue.
+	list, err = cities.Import(list, municipalities)
+	if err != nil {
+		log.Fatalf("Cities missing from the IBGE tables:\n%v", err)
+	}
+	if err := fetchCentroids(ctx, list, *concurrency); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := cities.Complete(list); err != nil {
+		log.Fatalf("Incomplete IBGE data:\n%v", err)
+	}
+	if err := cities.Validate(list); err != nil {
+		log.Fatalf("Invalid catalogue:\n%v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := cities.Write(&buf, list); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Wrote %d cities to %s\n", len(list), *out)
+}
+
+// fetchMunicipalities returns every municipality with its census population.
+func fetchMunicipalities(ctx context.Context) ([]cities.Municipality, error) {
+	var localidades []struct {
+		ID   int    `json:"id"`
+		Nome string `json:"nome"`
+	}
+	if err := getJSON(ctx, municipalitiesURL, &localidades); err != nil {
+		return nil, err
+	}
+
+	// SIDRA answers a table whose first row holds the column titles.
+	var rows []struct {
+		Code  string `json:"D1C"`
+		Value string `json:"V"`
+	}
+	if err := getJSON(ctx, populationURL, &rows); err != nil {
+		return nil, err
+	}
+	population := map[int]int{}
+	for i, row := range rows {
+		if i == 0 {
+			continue
+		}
+		code, err := strconv.Atoi(row.Code)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected SIDRA municipality code %q", row.Code)
+		}
+		n, err := strconv.Atoi(row.Value)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected SIDRA population %q of municipality %d", row.Value, code)
+		}
+		population[code] = n
+	}
+
+	municipalities := make([]cities.Municipality, 0, len(localidades))
+	for _, l := range localidades {
+		municipalities = append(municipalities, cities.Municipality{
+			Code:       l.ID,
+			Name:       l.Nome,
+			Population: population[l.ID],
+		})
+	}
+	return municipalities, nil
+}
+
+// fetchCentroids sets the coordinates of every city of list.
+func fetchCentroids(ctx context.Context, list []cities.City, concurrency int) error {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		semaphore = make(chan struct{}, max(concurrency, 1))
+	)
+	for i := range list {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(city *cities.City) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			var metadata []struct {
+				Centroid struct {
+					Latitude  float64 `json:"latitude"`
+					Longitude float64 `json:"longitude"`
+				} `json:"centroide"`
+			}
+			err := getJSON(ctx, fmt.Sprintf(centroidURL, city.IBGECode), &metadata)
+			if err == nil && len(metadata) == 0 {
+				err = fmt.Errorf("no boundary metadata for municipality %d", city.IBGECode)
+			}
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s (%s): %w", city.Name, city.UF, err)
+				}
+				mu.Unlock()
+				return
+			}
+			city.Latitude = metadata[0].Centroid.Latitude
+			city.Longitude = metadata[0].Centroid.Longitude
+		}(&list[i])
+	}
+	wg.Wait()
+	return firstErr
+}
+
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %s: %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+	return nil
+}
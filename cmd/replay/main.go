@@ -0,0 +1,118 @@
+// Command replay re-processes the archived SerpAPI responses of a run:
+// extraction, brand classification and enrichment run again and the results
+// are stored as a new version of the run. No SerpAPI credits are spent.
+//
+//	go run ./cmd/replay -run <run id> [-brand <profile>] [-enrich <a,b>|none]
+//
+// The brand profile and enrichers default to those of the original run.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/brand"
+	"google-monitoring/config"
+	"google-monitoring/enrich"
+	"google-monitoring/jobs"
+	"google-monitoring/monitor"
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+func main() {
+	runID := flag.String("run", "", "ID of the run to replay")
+	brandName := flag.String("brand", "", "brand profile to classify against (default: the run's)")
+	enrichers := flag.String("enrich", "", `comma-separated enrichers to run, or "none" (default: the run's)`)
+	flag.Parse()
+
+	sourceID, err := primitive.ObjectIDFromHex(*runID)
+	if err != nil {
+		log.Fatalf("Invalid -run %q: %v", *runID, err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	ctx := context.Background()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoURI))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Disconnect(ctx)
+
+	st := store.New(client, cfg.DbName)
+	source, err := st.GetRun(ctx, sourceID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	responses, err := st.ArchivedResponses(ctx, source.ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	replay := &providers.ReplayProvider{Responses: map[string]providers.ArchivedResponse{}}
+	for _, response := range responses {
+		replay.Responses[response.City] = providers.ArchivedResponse{Raw: response.Data, FetchedAt: response.FetchedAt}
+	}
+
+	var cities []string
+	for _, city := range source.Cities {
+		if _, ok := replay.Responses[city]; ok {
+			cities = append(cities, city)
+		}
+	}
+	if len(cities) == 0 {
+		log.Fatalf("Run %s has no archived responses", source.ID.Hex())
+	}
+
+	pipeline, err := enrich.FromConfig(cfg, st)
+	if err != nil {
+		log.Fatal(err)
+	}
+	m := monitor.New(st, replay, brand.NewStore(client, cfg.DbName), pipeline, nil, nil)
+	runner := jobs.NewRunner(st, m, func(string) int { return len(cities) }, nil)
+
+	original := source.ID
+	if source.ReplayOf != nil {
+		original = *source.ReplayOf
+	}
+
+	req := monitor.Request{
+		Query:     source.Query,
+		Cities:    cities,
+		Device:    source.Device,
+		Requester: "replay:" + source.ID.Hex(),
+		Tenant:    source.Tenant,
+		Brand:     source.BrandProfile,
+		Enrichers: source.Enrichers,
+		ReplayOf:  &original,
+	}
+	if *brandName != "" {
+		req.Brand = *brandName
+	}
+	switch *enrichers {
+	case "":
+	case "none":
+		req.Enrichers = []string{}
+	default:
+		req.Enrichers = strings.Split(*enrichers, ",")
+	}
+
+	outcome, err := runner.Run(ctx, req)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Replayed run %s as run %s (version %d): %s, %d of %d cities failed\n",
+		source.ID.Hex(), outcome.Run.ID.Hex(), outcome.Run.Version, outcome.Run.Status, len(outcome.Errors), len(cities))
+}
@@ -0,0 +1,154 @@
+// Command tenants manages the tenants of a deployment and their API keys.
+//
+//	go run ./cmd/tenants list
+//	go run ./cmd/tenants create <name>
+//	go run ./cmd/tenants issue-key <name> analyst|admin [label]
+//	go run ./cmd/tenants set-role <name> <key id> analyst|admin
+//	go run ./cmd/tenants revoke-key <name> <key id>
+//	go run ./cmd/tenants disable|enable <name>
+//	go run ./cmd/tenants adopt <name>
+//
+// adopt assigns the data stored before tenants existed to a tenant; until
+// then no API key can see it.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/access"
+	"google-monitoring/config"
+	"google-monitoring/tenants"
+)
+
+// ownedCollections hold documents with a tenant field.
+var ownedCollections = []string{
+	"runs",
+	"observations",
+	"ad_changes",
+	"brand_profiles",
+	"city_groups",
+	"schedules",
+}
+
+const usage = "usage: tenants list | create <name> | issue-key <name> <role> [label] | set-role <name> <key id> <role> | revoke-key <name> <key id> | disable <name> | enable <name> | adopt <name>"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+	command, args := os.Args[1], os.Args[2:]
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	ctx := context.Background()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoURI))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Disconnect(ctx)
+
+	store := tenants.NewStore(client, cfg.DbName)
+	if err := store.EnsureIndexes(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	switch {
+	case command == "list" && len(args) == 0:
+		list, err := store.List(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, t := range list {
+			status := "enabled"
+			if t.Disabled {
+				status = "disabled"
+			}
+			fmt.Printf("%s\t%s\n", t.Name, status)
+			for _, key := range t.Keys {
+				fmt.Printf("\t%s\t%s...\t%s\t%s\t%s\n", key.ID.Hex(), key.Prefix, key.Role, key.CreatedAt.Format("2006-01-02"), key.Label)
+			}
+		}
+
+	case command == "create" && len(args) == 1:
+		if _, err := store.Create(ctx, args[0]); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Created tenant %s\n", args[0])
+
+	case command == "issue-key" && (len(args) == 2 || len(args) == 3):
+		role, err := access.ParseRole(args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		label := ""
+		if len(args) == 3 {
+			label = args[2]
+		}
+		key, apiKey, err := store.IssueKey(ctx, args[0], label, role)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Issued key %s for tenant %s. It is shown only once:\n%s\n", apiKey.ID.Hex(), args[0], key)
+
+	case command == "set-role" && len(args) == 3:
+		id, err := primitive.ObjectIDFromHex(args[1])
+		if err != nil {
+			log.Fatalf("Invalid key id %q: %v", args[1], err)
+		}
+		role, err := access.ParseRole(args[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := store.SetKeyRole(ctx, args[0], id, role); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Key %s of tenant %s is now %s\n", args[1], args[0], role)
+
+	case command == "revoke-key" && len(args) == 2:
+		id, err := primitive.ObjectIDFromHex(args[1])
+		if err != nil {
+			log.Fatalf("Invalid key id %q: %v", args[1], err)
+		}
+		if err := store.RevokeKey(ctx, args[0], id); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Revoked key %s of tenant %s\n", args[1], args[0])
+
+	case (command == "disable" || command == "enable") && len(args) == 1:
+		if err := store.SetDisabled(ctx, args[0], command == "disable"); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Tenant %s %sd\n", args[0], command)
+
+	case command == "adopt" && len(args) == 1:
+		if _, err := store.Get(ctx, args[0]); err != nil {
+			log.Fatal(err)
+		}
+		db := client.Database(cfg.DbName)
+		for _, name := range ownedCollections {
+			result, err := db.Collection(name).UpdateMany(ctx,
+				bson.M{"tenant": bson.M{"$in": bson.A{nil, ""}}},
+				bson.M{"$set": bson.M{"tenant": args[0]}},
+			)
+			if err != nil {
+				log.Fatalf("Failed to adopt %s: %v", name, err)
+			}
+			fmt.Printf("%s: %d documents assigned to %s\n", name, result.ModifiedCount, args[0])
+		}
+
+	default:
+		log.Fatal(usage + "\nunknown command: " + strings.Join(os.Args[1:], " "))
+	}
+}
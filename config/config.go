@@ -1,37 +1,508 @@
+// Package config loads the settings of the service once at startup.
+//
+// Settings are named like environment variables (MONGODB_URI, PORT, ...).
+// Each one is read, by decreasing precedence, from the environment, from a
+// .env file in the working directory, from the YAML or JSON file named by
+// CONFIG_FILE, and finally from its default. In the file, names may be
+// written in lower case; lists may be YAML sequences and name=number maps
+// YAML mappings.
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	MongoURI           string
+	// HTTP server.
+	Port             int
+	HTTPReadTimeout  time.Duration
+	HTTPWriteTimeout time.Duration
+	HTTPIdleTimeout  time.Duration
+
+	MongoURI     string
+	DbName       string
+	MongoTimeout time.Duration
+
 	SerpAPIKey         string
 	CustomSearchAPIKey string
 	SearchEngineID     string
-	DbName             string
 	MailFrom           string
 	MailPassword       string
+	SMTPHost           string
+	SMTPPort           int
+	SMTPUsername       string
+	SMTPTLS            string
+	WebhookSecret      string
+	TelegramBotToken   string
+	TelegramAPIURL     string
+	ReportLocale       string
+	ReportSubject      string
+	SerpProvider       string
+	SerpFixtureDir     string
+	MinCities          int
+	MaxCities          int
+	SearchLimit        int
+	TenantSearchLimits map[string]int
+	EnrichConcurrency  int
+	LandingPages       bool
+	LandingUserAgent   string
+	// SchedulerWorkers caps the schedules an instance runs at once.
+	SchedulerWorkers      int
+	SchedulerPollInterval time.Duration
+	// SerpCacheTTL of zero disables the SERP cache.
+	SerpCacheTTL   time.Duration
+	SerpCacheSize  int
+	SerpCacheMongo bool
+	// Credit budgets per tenant; zero means unlimited.
+	DailyCreditBudget          int
+	MonthlyCreditBudget        int
+	TenantDailyCreditBudgets   map[string]int
+	TenantMonthlyCreditBudgets map[string]int
+	// JWTSecret enables HS256 bearer tokens next to API keys.
+	JWTSecret string
+	// CORS policy; no allowed origins rejects every cross-origin request.
+	CORSAllowedOrigins   []string
+	CORSAllowedMethods   []string
+	CORSAllowedHeaders   []string
+	CORSExposedHeaders   []string
+	CORSAllowCredentials bool
+	CORSMaxAge           time.Duration
+	// Rate limits per client; SearchRateLimit applies to starting searches.
+	// AddressRateLimit applies per client address before authentication, so
+	// that requests with invalid credentials are throttled too.
+	RateLimit        Rate
+	SearchRateLimit  Rate
+	AddressRateLimit Rate
+	RateLimitsShared bool
+}
+
+// Rate is a number of requests per period, written "10/1m". Zero requests
+// means unlimited.
+type Rate struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d/%s", r.Requests, r.Per)
+}
+
+// Load reads and validates the configuration. A missing .env file is not an
+// error. Every invalid setting is reported in the returned error.
+func Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read .env: %w", err)
+	}
+
+	l := &loader{used: map[string]bool{}}
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		file, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		l.file = file
+	}
+
+	cfg := &Config{
+		Port:             l.int("PORT", 8080),
+		HTTPReadTimeout:  l.duration("HTTP_READ_TIMEOUT", 30*time.Second),
+		HTTPWriteTimeout: l.duration("HTTP_WRITE_TIMEOUT", 5*time.Minute),
+		HTTPIdleTimeout:  l.duration("HTTP_IDLE_TIMEOUT", 2*time.Minute),
+
+		MongoURI:     l.string("MONGODB_URI", ""),
+		DbName:       l.string("MONGODB_NAME", ""),
+		MongoTimeout: l.duration("MONGODB_TIMEOUT", 10*time.Second),
+
+		SerpAPIKey:         l.string("SERP_API_KEY", ""),
+		CustomSearchAPIKey: l.string("CUSTOM_SEARCH_API_KEY", ""),
+		SearchEngineID:     l.string("SEARCH_ENGINE_ID", ""),
+		MailFrom:           l.string("MAIL_FROM", ""),
+		MailPassword:       l.string("MAIL_PASSWORD", ""),
+		SMTPHost:           l.string("SMTP_HOST", "smtp.gmail.com"),
+		SMTPPort:           l.int("SMTP_PORT", 587),
+		SMTPTLS:            l.string("SMTP_TLS", "starttls"),
+		WebhookSecret:      l.string("WEBHOOK_SECRET", ""),
+		TelegramBotToken:   l.string("TELEGRAM_BOT_TOKEN", ""),
+		TelegramAPIURL:     l.string("TELEGRAM_API_URL", ""),
+		ReportLocale:       l.string("REPORT_LOCALE", "pt-BR"),
+		ReportSubject:      l.string("REPORT_SUBJECT", ""),
+		SerpProvider:       l.string("SERP_PROVIDER", "serpapi"),
+		SerpFixtureDir:     l.string("SERP_FIXTURE_DIR", ""),
+		MinCities:          l.int("MIN_CITIES", 1),
+		MaxCities:          l.int("MAX_CITIES", 100),
+		SearchLimit:        l.int("SEARCH_LIMIT", 20),
+		TenantSearchLimits: l.intMap("TENANT_SEARCH_LIMITS"),
+		EnrichConcurrency:  l.int("ENRICH_CONCURRENCY", 4),
+		LandingPages:       l.bool("LANDING_PAGES", true),
+		LandingUserAgent:   l.string("LANDING_USER_AGENT", ""),
+
+		SchedulerWorkers:      l.int("SCHEDULER_WORKERS", 4),
+		SchedulerPollInterval: l.duration("SCHEDULER_POLL_INTERVAL", 30*time.Second),
+
+		SerpCacheTTL:   l.duration("SERP_CACHE_TTL", 10*time.Minute),
+		SerpCacheSize:  l.int("SERP_CACHE_SIZE", 1000),
+		SerpCacheMongo: l.bool("SERP_CACHE_MONGO", false),
+
+		DailyCreditBudget:          l.int("DAILY_CREDIT_BUDGET", 0),
+		MonthlyCreditBudget:        l.int("MONTHLY_CREDIT_BUDGET", 0),
+		TenantDailyCreditBudgets:   l.intMap("TENANT_DAILY_CREDIT_BUDGETS"),
+		TenantMonthlyCreditBudgets: l.intMap("TENANT_MONTHLY_CREDIT_BUDGETS"),
+
+		JWTSecret: l.string("JWT_SECRET", ""),
+
+		CORSAllowedOrigins: l.list("CORS_ALLOWED_ORIGINS", nil),
+		CORSAllowedMethods: l.list("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE"}),
+		CORSAllowedHeaders: l.list("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "X-API-Key"}),
+		CORSExposedHeaders: l.list("CORS_EXPOSED_HEADERS", []string{
+			"Location", "X-Run-ID", "X-Cache", "Age", "Retry-After",
+			"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy",
+		}),
+		CORSAllowCredentials: l.bool("CORS_ALLOW_CREDENTIALS", false),
+		CORSMaxAge:           l.duration("CORS_MAX_AGE", 10*time.Minute),
+
+		RateLimit:        l.rate("RATE_LIMIT", Rate{Requests: 120, Per: time.Minute}),
+		SearchRateLimit:  l.rate("SEARCH_RATE_LIMIT", Rate{Requests: 10, Per: time.Minute}),
+		AddressRateLimit: l.rate("ADDRESS_RATE_LIMIT", Rate{Requests: 600, Per: time.Minute}),
+		RateLimitsShared: l.bool("RATE_LIMITS_SHARED", false),
+	}
+	cfg.SMTPUsername = l.string("SMTP_USERNAME", cfg.MailFrom)
+
+	for key := range l.file {
+		if !l.used[key] && key != "CONFIG_FILE" {
+			l.errs = append(l.errs, fmt.Errorf("%s: unknown setting in %s", key, os.Getenv("CONFIG_FILE")))
+		}
+	}
+	if err := errors.Join(append(l.errs, cfg.Validate())...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that the required settings are present and that the
+// others make sense together.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(key, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	if c.MongoURI == "" {
+		invalid("MONGODB_URI", "is required")
+	}
+	if c.DbName == "" {
+		invalid("MONGODB_NAME", "is required")
+	}
+	switch c.SerpProvider {
+	case "serpapi":
+		if c.SerpAPIKey == "" {
+			invalid("SERP_API_KEY", "is required when SERP_PROVIDER is serpapi")
+		}
+	case "fixture":
+		if c.SerpFixtureDir == "" {
+			invalid("SERP_FIXTURE_DIR", "is required when SERP_PROVIDER is fixture")
+		}
+	default:
+		invalid("SERP_PROVIDER", "must be serpapi or fixture, not %q", c.SerpProvider)
+	}
+
+	if c.Port < 1 || c.Port > 65535 {
+		invalid("PORT", "must be between 1 and 65535, not %d", c.Port)
+	}
+	if c.SMTPPort < 1 || c.SMTPPort > 65535 {
+		invalid("SMTP_PORT", "must be between 1 and 65535, not %d", c.SMTPPort)
+	}
+	switch c.SMTPTLS {
+	case "starttls", "tls", "none":
+	default:
+		invalid("SMTP_TLS", "must be starttls, tls or none, not %q", c.SMTPTLS)
+	}
+
+	if c.MinCities < 1 {
+		invalid("MIN_CITIES", "must be at least 1")
+	}
+	if c.MaxCities < c.MinCities {
+		invalid("MAX_CITIES", "must not be below MIN_CITIES (%d)", c.MinCities)
+	}
+	if c.SearchLimit < 1 {
+		invalid("SEARCH_LIMIT", "must be at least 1")
+	}
+	for tenant, limit := range c.TenantSearchLimits {
+		if limit < 1 {
+			invalid("TENANT_SEARCH_LIMITS", "limit of %s must be at least 1", tenant)
+		}
+	}
+	if c.EnrichConcurrency < 1 {
+		invalid("ENRICH_CONCURRENCY", "must be at least 1")
+	}
+	if c.SchedulerWorkers < 1 {
+		invalid("SCHEDULER_WORKERS", "must be at least 1")
+	}
+	if c.SerpCacheTTL > 0 && c.SerpCacheSize < 1 {
+		invalid("SERP_CACHE_SIZE", "must be at least 1 when the SERP cache is enabled")
+	}
+
+	for key, budget := range map[string]int{"DAILY_CREDIT_BUDGET": c.DailyCreditBudget, "MONTHLY_CREDIT_BUDGET": c.MonthlyCreditBudget} {
+		if budget < 0 {
+			invalid(key, "must not be negative")
+		}
+	}
+	for key, budgets := range map[string]map[string]int{
+		"TENANT_DAILY_CREDIT_BUDGETS":   c.TenantDailyCreditBudgets,
+		"TENANT_MONTHLY_CREDIT_BUDGETS": c.TenantMonthlyCreditBudgets,
+	} {
+		for tenant, budget := range budgets {
+			if budget < 0 {
+				invalid(key, "budget of %s must not be negative", tenant)
+			}
+		}
+	}
+
+	for key, d := range map[string]time.Duration{
+		"HTTP_READ_TIMEOUT":       c.HTTPReadTimeout,
+		"HTTP_WRITE_TIMEOUT":      c.HTTPWriteTimeout,
+		"HTTP_IDLE_TIMEOUT":       c.HTTPIdleTimeout,
+		"MONGODB_TIMEOUT":         c.MongoTimeout,
+		"SCHEDULER_POLL_INTERVAL": c.SchedulerPollInterval,
+	} {
+		if d <= 0 {
+			invalid(key, "must be positive")
+		}
+	}
+	for key, d := range map[string]time.Duration{"SERP_CACHE_TTL": c.SerpCacheTTL, "CORS_MAX_AGE": c.CORSMaxAge} {
+		if d < 0 {
+			invalid(key, "must not be negative")
+		}
+	}
+
+	if len(c.CORSAllowedMethods) == 0 {
+		invalid("CORS_ALLOWED_METHODS", "at least one method is required")
+	}
+	for _, origin := range c.CORSAllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			invalid("CORS_ALLOWED_ORIGINS", "invalid origin %q, expected scheme://host", origin)
+		}
+	}
+
+	// sort keeps the report stable, as maps above are walked in random order.
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
 }
 
-func LoadConfig() *Config {
-	err := godotenv.Load()
+// SearchLimitFor returns the number of SERP lookups a single request of
+// tenant may spend, falling back to SearchLimit.
+func (c *Config) SearchLimitFor(tenant string) int {
+	if limit, ok := c.TenantSearchLimits[tenant]; ok {
+		return limit
+	}
+	return c.SearchLimit
+}
+
+// plain has the fields of Config without its String method.
+type plain Config
+
+// String prints c with its secrets masked, so it is safe to log.
+func (c *Config) String() string {
+	redacted := plain(*c)
+	for _, secret := range []*string{
+		&redacted.SerpAPIKey,
+		&redacted.CustomSearchAPIKey,
+		&redacted.MailPassword,
+		&redacted.WebhookSecret,
+		&redacted.TelegramBotToken,
+		&redacted.JWTSecret,
+	} {
+		if *secret != "" {
+			*secret = "[redacted]"
+		}
+	}
+	if u, err := url.Parse(redacted.MongoURI); err == nil && u.User != nil {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), "redacted")
+			redacted.MongoURI = u.String()
+		}
+	} else if err != nil {
+		redacted.MongoURI = "[redacted]"
+	}
+	return fmt.Sprintf("%+v", redacted)
+}
+
+// readFile reads the settings of a YAML or JSON file; JSON being YAML, one
+// parser reads both.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
 	}
 
-	config := &Config{
-		MongoURI:           os.Getenv("MONGODB_URI"),
-		SerpAPIKey:         os.Getenv("SERP_API_KEY"),
-		CustomSearchAPIKey: os.Getenv("CUSTOM_SEARCH_API_KEY"),
-		SearchEngineID:     os.Getenv("SEARCH_ENGINE_ID"),
-		DbName:             os.Getenv("MONGODB_NAME"),
-		MailFrom:           os.Getenv("MAIL_FROM"),
-		MailPassword:       os.Getenv("MAIL_PASSWORD"),
+	settings := map[string]string{}
+	for key, value := range raw {
+		key = strings.ToUpper(key)
+		switch v := value.(type) {
+		case nil:
+		case []interface{}:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprint(item)
+			}
+			settings[key] = strings.Join(items, ",")
+		case map[string]interface{}:
+			pairs := make([]string, 0, len(v))
+			for name, n := range v {
+				pairs = append(pairs, fmt.Sprintf("%s=%v", name, n))
+			}
+			sort.Strings(pairs)
+			settings[key] = strings.Join(pairs, ",")
+		default:
+			settings[key] = fmt.Sprint(v)
+		}
 	}
+	return settings, nil
+}
+
+// loader reads typed settings and collects the errors of the invalid ones.
+type loader struct {
+	file map[string]string
+	used map[string]bool
+	errs []error
+}
+
+// lookup returns the value of key from the environment or the config file.
+// Empty values count as unset.
+func (l *loader) lookup(key string) (string, bool) {
+	l.used[key] = true
+	if value := os.Getenv(key); value != "" {
+		return value, true
+	}
+	value := l.file[key]
+	return value, value != ""
+}
+
+func (l *loader) invalid(key, value, expected string) {
+	l.errs = append(l.errs, fmt.Errorf("%s: invalid value %q, expected %s", key, value, expected))
+}
+
+func (l *loader) string(key, fallback string) string {
+	if value, ok := l.lookup(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func (l *loader) int(key string, fallback int) int {
+	value, ok := l.lookup(key)
+	if !ok {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		l.invalid(key, value, "an integer")
+		return fallback
+	}
+	return n
+}
 
-	return config
+func (l *loader) bool(key string, fallback bool) bool {
+	value, ok := l.lookup(key)
+	if !ok {
+		return fallback
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		l.invalid(key, value, "true or false")
+		return fallback
+	}
+	return b
+}
+
+func (l *loader) duration(key string, fallback time.Duration) time.Duration {
+	value, ok := l.lookup(key)
+	if !ok {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		l.invalid(key, value, "a duration such as 30s or 10m")
+		return fallback
+	}
+	return d
+}
+
+// list parses comma-separated lists.
+func (l *loader) list(key string, fallback []string) []string {
+	value, ok := l.lookup(key)
+	if !ok {
+		return fallback
+	}
+
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// intMap parses "name=value,other=value" lists.
+func (l *loader) intMap(key string) map[string]int {
+	values := map[string]int{}
+	value, ok := l.lookup(key)
+	if !ok {
+		return values
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		name, number, ok := strings.Cut(pair, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(number))
+		if !ok || err != nil {
+			l.invalid(key, pair, "name=number")
+			continue
+		}
+		values[strings.TrimSpace(name)] = n
+	}
+	return values
+}
+
+// rate parses "requests/duration" rates such as "10/1m".
+func (l *loader) rate(key string, fallback Rate) Rate {
+	value, ok := l.lookup(key)
+	if !ok {
+		return fallback
+	}
+
+	requests, per, ok := strings.Cut(value, "/")
+	n, err := strconv.Atoi(strings.TrimSpace(requests))
+	d, perErr := time.ParseDuration(strings.TrimSpace(per))
+	if !ok || err != nil || n < 0 || perErr != nil || d <= 0 {
+		l.invalid(key, value, "requests/duration such as 10/1m")
+		return fallback
+	}
+	return Rate{Requests: n, Per: d}
 }
@@ -0,0 +1,171 @@
+// Package domains normalizes the links found on result pages so the same
+// advertiser is recognised across tracking parameters, redirects and
+// subdomains.
+package domains
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// trackingParams are query parameters that only identify a click or a
+// campaign and never change the page served.
+var trackingParams = map[string]bool{
+	"gclid": true, "gclsrc": true, "gbraid": true, "wbraid": true, "dclid": true,
+	"fbclid": true, "msclkid": true, "yclid": true, "ttclid": true, "twclid": true,
+	"srsltid": true, "_ga": true, "_gl": true, "mc_cid": true, "mc_eid": true,
+	"igshid": true, "ref_src": true,
+}
+
+// trackingPrefixes are prefixes of campaign parameter families such as utm_source.
+var trackingPrefixes = []string{"utm_", "pk_", "mtm_", "hsa_"}
+
+// redirectParams maps known redirectors, by host and then path, to the
+// parameters that hold the destination, e.g. Google's /aclk?adurl= and
+// /url?q=. "google" stands for every Google search domain. Only these hosts
+// are trusted: anyone can serve /url?q= on their own site, and unwrapping it
+// would let an advertiser pass for the site it redirects to.
+var redirectParams = map[string]map[string][]string{
+	"google": {
+		"/url":  {"q", "url"},
+		"/aclk": {"adurl"},
+	},
+	"googleadservices.com": {
+		"/pagead/aclk": {"adurl"},
+	},
+	"l.facebook.com": {
+		"/l.php": {"u"},
+	},
+}
+
+// Parse parses a link, adding a scheme when it has none as displayed links
+// usually come without one.
+func Parse(link string) (*url.URL, error) {
+	link = strings.TrimSpace(link)
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	return url.Parse(link)
+}
+
+// Host returns the lower-cased host of link without a leading "www.", or ""
+// when link cannot be parsed.
+func Host(link string) string {
+	if strings.TrimSpace(link) == "" {
+		return ""
+	}
+	u, err := Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// Registrable returns the registrable domain of host: the public suffix plus
+// one label, so "loja.exemplo.com.br" becomes "exemplo.com.br". IP addresses,
+// bare suffixes and malformed hosts are returned unchanged.
+func Registrable(host string) string {
+	host = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(host)), "www."), ".")
+	if host == "" || net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// Advertiser returns the registrable domain link leads to once redirects are
+// unwrapped.
+func Advertiser(link string) string {
+	return Registrable(Host(Unwrap(link)))
+}
+
+// Unwrap follows known redirector links to their destination, for up to
+// five hops. Links that are not redirects are returned as they are.
+func Unwrap(link string) string {
+	for range 5 {
+		u, err := Parse(link)
+		if err != nil {
+			return link
+		}
+		target := redirectTarget(u)
+		if target == "" {
+			return link
+		}
+		link = target
+	}
+	return link
+}
+
+func redirectTarget(u *url.URL) string {
+	params, ok := redirectParams[redirector(u.Hostname())][u.Path]
+	if !ok {
+		return ""
+	}
+	query := u.Query()
+	for _, param := range params {
+		if target := query.Get(param); strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+			return target
+		}
+	}
+	return ""
+}
+
+// redirector returns the key of host in redirectParams: the host without
+// "www.", or "google" for google.com, google.com.br and the other Google
+// search domains.
+func redirector(host string) string {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	suffix, icann := publicsuffix.PublicSuffix(host)
+	if icann && host == "google."+suffix {
+		return "google"
+	}
+	return host
+}
+
+// Clean unwraps redirects and removes tracking parameters and the fragment
+// from link. The host is lower-cased; the path and remaining parameters are
+// kept. Links that cannot be parsed are returned trimmed but otherwise as
+// they are.
+func Clean(link string) string {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return ""
+	}
+
+	u, err := Parse(Unwrap(link))
+	if err != nil || u.Host == "" {
+		return link
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	query := u.Query()
+	for param := range query {
+		if isTracking(param) {
+			query.Del(param)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func isTracking(param string) bool {
+	param = strings.ToLower(param)
+	if trackingParams[param] {
+		return true
+	}
+	for _, prefix := range trackingPrefixes {
+		if strings.HasPrefix(param, prefix) {
+			return true
+		}
+	}
+	return false
+}
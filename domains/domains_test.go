@@ -0,0 +1,188 @@
+package domains
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRegistrable(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"exemplo.com.br", "exemplo.com.br"},
+		{"loja.exemplo.com.br", "exemplo.com.br"},
+		{"www.exemplo.com.br", "exemplo.com.br"},
+		{"a.b.c.loja.exemplo.com.br", "exemplo.com.br"},
+		{"WWW.Exemplo.COM", "exemplo.com"},
+		{"exemplo.com.", "exemplo.com"},
+		{" exemplo.com ", "exemplo.com"},
+		{"shop.example.co.uk", "example.co.uk"},
+		{"192.168.0.10", "192.168.0.10"},
+		{"2001:db8::1", "2001:db8::1"},
+		{"com.br", "com.br"},
+		{"com", "com"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Registrable(tt.host); got != tt.want {
+			t.Errorf("Registrable(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestHost(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{"https://www.Loja.com.br/tenis?x=1", "loja.com.br"},
+		{"loja.com.br/tenis", "loja.com.br"},
+		{"http://loja.com.br:8080/", "loja.com.br"},
+		{"", ""},
+		{"   ", ""},
+	}
+	for _, tt := range tests {
+		if got := Host(tt.link); got != tt.want {
+			t.Errorf("Host(%q) = %q, want %q", tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{
+			"https://loja.com.br/tenis?utm_source=google&utm_medium=cpc&utm_campaign=x&cor=azul",
+			"https://loja.com.br/tenis?cor=azul",
+		},
+		{"https://loja.com.br/?gclid=abc&fbclid=def&msclkid=ghi", "https://loja.com.br/"},
+		{"https://loja.com.br/?UTM_Source=x&tamanho=42&page=2", "https://loja.com.br/?page=2&tamanho=42"},
+		{"https://LOJA.com.br/Tenis#avaliacoes", "https://loja.com.br/Tenis"},
+		{"https://loja.com.br/busca?q=t%C3%AAnis+azul", "https://loja.com.br/busca?q=t%C3%AAnis+azul"},
+		{
+			"https://www.google.com/aclk?sa=l&adurl=https%3A%2F%2Floja.com.br%2Ftenis%3Fgclid%3Dx%26cor%3Dazul",
+			"https://loja.com.br/tenis?cor=azul",
+		},
+		{"  loja.com.br/tenis  ", "https://loja.com.br/tenis"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Clean(tt.link); got != tt.want {
+			t.Errorf("Clean(%q) = %q, want %q", tt.link, got, tt.want)
+		}
+	}
+}
+
+// redirect wraps target in a link to redirector, which passes it in param.
+func redirect(redirector, param, target string) string {
+	return redirector + "?" + url.Values{param: {target}}.Encode()
+}
+
+func TestUnwrap(t *testing.T) {
+	const brand = "https://marca.com.br/"
+
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"not a redirect", brand, brand},
+		{"google search", redirect("https://www.google.com/url", "q", brand), brand},
+		{"google search, url param", redirect("https://www.google.com/url", "url", brand), brand},
+		{"local google domain", redirect("https://www.google.com.br/aclk", "adurl", brand), brand},
+		{"google without www", redirect("https://google.com.br/url", "q", brand), brand},
+		{"google ad services", redirect("https://www.googleadservices.com/pagead/aclk", "adurl", brand), brand},
+		{"facebook", redirect("https://l.facebook.com/l.php", "u", brand), brand},
+		{
+			"nested",
+			redirect("https://www.google.com/url", "q", redirect("https://www.googleadservices.com/pagead/aclk", "adurl", brand)),
+			brand,
+		},
+		{"redirect path on another host", redirect("https://infrator.com/redirect", "url", brand), "https://infrator.com/redirect?url=https%3A%2F%2Fmarca.com.br%2F"},
+		{"google path on another host", redirect("https://evil.com/url", "q", brand), "https://evil.com/url?q=https%3A%2F%2Fmarca.com.br%2F"},
+		{"google as a subdomain", redirect("https://google.evil.com/url", "q", brand), "https://google.evil.com/url?q=https%3A%2F%2Fmarca.com.br%2F"},
+		{"google on a private suffix", redirect("https://google.blogspot.com/url", "q", brand), "https://google.blogspot.com/url?q=https%3A%2F%2Fmarca.com.br%2F"},
+		{"other google path", redirect("https://www.google.com/search", "q", brand), "https://www.google.com/search?q=https%3A%2F%2Fmarca.com.br%2F"},
+		{"destination is not a url", redirect("https://www.google.com/url", "q", "marca"), "https://www.google.com/url?q=marca"},
+		{"unsupported scheme", redirect("https://www.google.com/url", "q", "javascript:alert(1)"), "https://www.google.com/url?q=javascript%3Aalert%281%29"},
+	}
+	for _, tt := range tests {
+		if got := Unwrap(tt.link); got != tt.want {
+			t.Errorf("%s: Unwrap(%q) = %q, want %q", tt.name, tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestUnwrapStopsAfterFiveHops(t *testing.T) {
+	link := "https://marca.com.br/"
+	var hops []string
+	for range 7 {
+		link = redirect("https://www.google.com/url", "q", link)
+		hops = append(hops, link)
+	}
+
+	// Five hops from the outermost link leave two redirects around it.
+	if got := Unwrap(link); got != hops[1] {
+		t.Errorf("Unwrap = %q, want %q", got, hops[1])
+	}
+	if got := Unwrap(hops[4]); got != "https://marca.com.br/" {
+		t.Errorf("Unwrap of five redirects = %q, want the destination", got)
+	}
+}
+
+func TestAdvertiser(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{"https://loja.marca.com.br/tenis", "marca.com.br"},
+		{redirect("https://www.googleadservices.com/pagead/aclk", "adurl", "https://www.marca.com.br/"), "marca.com.br"},
+		{redirect("https://infrator.com/redirect", "url", "https://www.marca.com.br/"), "infrator.com"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Advertiser(tt.link); got != tt.want {
+			t.Errorf("Advertiser(%q) = %q, want %q", tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	links := []string{
+		"https://www.loja.com.br/a",
+		"https://m.loja.com.br/b",
+		redirect("https://www.google.com/aclk", "adurl", "https://outra.com/c"),
+		"https://loja.com.br/d",
+		"",
+		"https://outra.com/e",
+		"https://terceira.net/f",
+	}
+
+	groups := GroupBy(links, func(link string) string { return link })
+
+	want := []struct {
+		domain string
+		hosts  string
+		items  int
+	}{
+		{"loja.com.br", "loja.com.br m.loja.com.br", 3},
+		{"outra.com", "outra.com", 2},
+		{"terceira.net", "terceira.net", 1},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups, want %d: %+v", len(groups), len(want), groups)
+	}
+	for i, w := range want {
+		g := groups[i]
+		if g.Domain != w.domain || strings.Join(g.Hosts, " ") != w.hosts || len(g.Items) != w.items {
+			t.Errorf("group %d = {%s %v %d items}, want {%s %s %d items}", i, g.Domain, g.Hosts, len(g.Items), w.domain, w.hosts, w.items)
+		}
+	}
+	if groups[1].Items[0] != links[2] {
+		t.Errorf("group items hold %q, want the original link", groups[1].Items[0])
+	}
+}
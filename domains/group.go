@@ -0,0 +1,59 @@
+package domains
+
+import "sort"
+
+// Group is the set of items that lead to one advertiser.
+type Group[T any] struct {
+	Domain string
+	Hosts  []string
+	Items  []T
+}
+
+// GroupBy groups items by the registrable domain of the link returned by
+// linkOf, keeping the hosts seen under each domain. Items without a usable
+// link are left out. Groups are ordered by size, then domain.
+func GroupBy[T any](items []T, linkOf func(T) string) []Group[T] {
+	byDomain := map[string]*Group[T]{}
+	var order []string
+
+	for _, item := range items {
+		link := Unwrap(linkOf(item))
+		host := Host(link)
+		if host == "" {
+			continue
+		}
+		domain := Registrable(host)
+
+		group, ok := byDomain[domain]
+		if !ok {
+			group = &Group[T]{Domain: domain}
+			byDomain[domain] = group
+			order = append(order, domain)
+		}
+		group.Items = append(group.Items, item)
+		if !contains(group.Hosts, host) {
+			group.Hosts = append(group.Hosts, host)
+		}
+	}
+
+	groups := make([]Group[T], 0, len(order))
+	for _, domain := range order {
+		groups = append(groups, *byDomain[domain])
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if len(groups[i].Items) != len(groups[j].Items) {
+			return len(groups[i].Items) > len(groups[j].Items)
+		}
+		return groups[i].Domain < groups[j].Domain
+	})
+	return groups
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package enrich
+
+import (
+	"context"
+
+	"google-monitoring/config"
+)
+
+// FromConfig registers the enrichers that are configured. Custom Search runs
+// only when both its API key and engine ID are set; landing pages are
+// fetched unless LANDING_PAGES is false.
+func FromConfig(cfg *config.Config, snapshots SnapshotStore) (*Pipeline, error) {
+	var enrichers []Enricher
+	if cfg.CustomSearchAPIKey != "" && cfg.SearchEngineID != "" {
+		customSearch, err := NewCustomSearch(context.Background(), cfg.CustomSearchAPIKey, cfg.SearchEngineID)
+		if err != nil {
+			return nil, err
+		}
+		enrichers = append(enrichers, customSearch)
+	}
+	if cfg.LandingPages {
+		enrichers = append(enrichers, &LandingPages{Snapshots: snapshots, UserAgent: cfg.LandingUserAgent})
+	}
+	return NewPipeline(cfg.EnrichConcurrency, enrichers...), nil
+}
@@ -0,0 +1,51 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/customsearch/v1"
+	"google.golang.org/api/option"
+
+	"google-monitoring/store"
+)
+
+// CustomSearch replaces the title, snippet and link of a result with the
+// first Google Custom Search hit for the query restricted to the result's
+// link. Results without a hit keep what the SERP showed.
+type CustomSearch struct {
+	service  *customsearch.Service
+	engineID string
+}
+
+func NewCustomSearch(ctx context.Context, apiKey, engineID string) (*CustomSearch, error) {
+	service, err := customsearch.NewService(ctx, option.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom search client: %w", err)
+	}
+	return &CustomSearch{service: service, engineID: engineID}, nil
+}
+
+func (c *CustomSearch) Name() string {
+	return "custom_search"
+}
+
+func (c *CustomSearch) Enrich(ctx context.Context, query string, result *store.Result) error {
+	if result.Serp.Link == "" {
+		return nil
+	}
+
+	resp, err := c.service.Cse.List().Cx(c.engineID).Q(query).SiteSearch(result.Serp.Link).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("custom search for %s failed: %w", result.Serp.Link, err)
+	}
+	if resp == nil || len(resp.Items) == 0 {
+		return nil
+	}
+
+	item := resp.Items[0]
+	result.Title = item.Title
+	result.Snippet = item.Snippet
+	result.Link = item.Link
+	return nil
+}
@@ -0,0 +1,125 @@
+// Package enrich adds information to the results of a SERP lookup through a
+// chain of enrichers, such as a Custom Search lookup or a landing page fetch.
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google-monitoring/store"
+)
+
+// DefaultConcurrency bounds the results enriched at once when a pipeline is
+// created with a concurrency of zero.
+const DefaultConcurrency = 4
+
+// Enricher adds information to one result. An error is recorded on the
+// result and does not stop the following enrichers.
+type Enricher interface {
+	Name() string
+	Enrich(ctx context.Context, query string, result *store.Result) error
+}
+
+// UnknownEnricherError is returned when a request names an enricher that is
+// not registered.
+type UnknownEnricherError struct {
+	Name string
+}
+
+func (e *UnknownEnricherError) Error() string {
+	return fmt.Sprintf("unknown enricher %q", e.Name)
+}
+
+// Pipeline holds the registered enrichers and runs the ones selected for a
+// run. Its concurrency bound is shared by every run using it.
+type Pipeline struct {
+	enrichers map[string]Enricher
+	defaults  []string
+	slots     chan struct{}
+}
+
+// NewPipeline registers enrichers, in the order they run by default. At most
+// concurrency results are enriched at the same time.
+func NewPipeline(concurrency int, enrichers ...Enricher) *Pipeline {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	p := &Pipeline{
+		enrichers: map[string]Enricher{},
+		slots:     make(chan struct{}, concurrency),
+	}
+	for _, e := range enrichers {
+		p.enrichers[e.Name()] = e
+		p.defaults = append(p.defaults, e.Name())
+	}
+	return p
+}
+
+// Names returns the registered enrichers, sorted.
+func (p *Pipeline) Names() []string {
+	names := make([]string, 0, len(p.enrichers))
+	for name := range p.enrichers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Select returns the enrichers called names, in that order. A nil names
+// selects every registered enricher; an empty one disables enrichment.
+func (p *Pipeline) Select(names []string) ([]Enricher, error) {
+	if names == nil {
+		names = p.defaults
+	}
+
+	stages := make([]Enricher, 0, len(names))
+	for _, name := range names {
+		e, ok := p.enrichers[strings.TrimSpace(name)]
+		if !ok {
+			return nil, &UnknownEnricherError{Name: name}
+		}
+		stages = append(stages, e)
+	}
+	return stages, nil
+}
+
+// Run passes every result through stages. Failures are recorded on the
+// result they happened on; Run only stops early when ctx is done.
+func (p *Pipeline) Run(ctx context.Context, query string, stages []Enricher, results []store.Result) {
+	if len(stages) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := range results {
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+
+		wg.Add(1)
+		go func(result *store.Result) {
+			defer wg.Done()
+			defer func() { <-p.slots }()
+
+			for _, stage := range stages {
+				if ctx.Err() != nil {
+					return
+				}
+				if err := stage.Enrich(ctx, query, result); err != nil {
+					result.EnrichmentErrors = append(result.EnrichmentErrors, store.EnrichmentError{
+						Enricher: stage.Name(),
+						Error:    err.Error(),
+					})
+				}
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+}
@@ -0,0 +1,276 @@
+package enrich
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/net/html"
+
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+const (
+	// DefaultMaxRedirects bounds the hops followed from an ad link.
+	DefaultMaxRedirects = 10
+	// DefaultMaxPageBytes bounds the HTML kept of a landing page.
+	DefaultMaxPageBytes = 2 << 20
+	// DefaultUserAgent is sent when LandingPages.UserAgent is empty.
+	DefaultUserAgent = "Mozilla/5.0 (compatible; google-monitoring/1.0)"
+)
+
+// SnapshotStore keeps the HTML of fetched landing pages. *store.Store
+// implements it with GridFS.
+type SnapshotStore interface {
+	SaveSnapshot(ctx context.Context, snapshot store.Snapshot) (primitive.ObjectID, error)
+}
+
+// ErrBlockedAddress is returned for a hop that leads to an address the
+// landing page fetcher must not reach, such as a private network host.
+var ErrBlockedAddress = errors.New("address is not publicly routable")
+
+// LandingPages fetches the landing page of every ad, following its redirect
+// chain hop by hop, and stores a snapshot of the final HTML as evidence.
+// Organic results are left alone. Zero fields take their defaults.
+//
+// Ad links and their redirects are chosen by advertisers, so only http and
+// https links are followed, and the default client refuses to connect to
+// anything but public addresses. A Client set in its place should use
+// PublicOnly as well.
+type LandingPages struct {
+	Client       *http.Client
+	Snapshots    SnapshotStore
+	UserAgent    string
+	MaxRedirects int
+	MaxPageBytes int64
+}
+
+func (l *LandingPages) Name() string {
+	return "landing_page"
+}
+
+func (l *LandingPages) Enrich(ctx context.Context, query string, result *store.Result) error {
+	if result.Serp.Kind != providers.KindAd || result.Serp.Link == "" {
+		return nil
+	}
+
+	landing := &store.LandingPage{FetchedAt: time.Now().UTC()}
+	result.Landing = landing
+
+	resp, err := l.follow(ctx, result.Serp.Link, landing)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	landing.FinalURL = resp.Request.URL.String()
+	landing.Status = resp.StatusCode
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, l.maxPageBytes()))
+	if err != nil {
+		return fmt.Errorf("failed to read landing page %s: %w", landing.FinalURL, err)
+	}
+	if !isHTML(resp.Header.Get("Content-Type"), body) {
+		return nil
+	}
+
+	landing.Title, landing.Description = pageSummary(body)
+
+	sum := sha256.Sum256(body)
+	landing.ContentHash = hex.EncodeToString(sum[:])
+
+	if l.Snapshots == nil {
+		return nil
+	}
+	landing.SnapshotID, err = l.Snapshots.SaveSnapshot(ctx, store.Snapshot{
+		URL:         landing.FinalURL,
+		ContentHash: landing.ContentHash,
+		HTML:        body,
+		FetchedAt:   landing.FetchedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store snapshot of %s: %w", landing.FinalURL, err)
+	}
+	return nil
+}
+
+// follow requests link and every redirect after it, recording each response
+// in landing.Hops, and returns the final response.
+func (l *LandingPages) follow(ctx context.Context, link string, landing *store.LandingPage) (*http.Response, error) {
+	client := *l.client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	for range l.maxRedirects() + 1 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid landing page link %q: %w", link, err)
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			err := fmt.Errorf("refusing to follow %s: unsupported scheme %q", link, req.URL.Scheme)
+			landing.Hops = append(landing.Hops, store.Hop{URL: link, Error: err.Error()})
+			return nil, err
+		}
+		req.Header.Set("User-Agent", l.userAgent())
+		req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8")
+
+		resp, err := client.Do(req)
+		if errors.Is(err, ErrBlockedAddress) {
+			err := fmt.Errorf("refusing to follow %s: %w", link, ErrBlockedAddress)
+			landing.Hops = append(landing.Hops, store.Hop{URL: link, Error: err.Error()})
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", link, err)
+		}
+		landing.Hops = append(landing.Hops, store.Hop{URL: link, Status: resp.StatusCode})
+
+		location := resp.Header.Get("Location")
+		if resp.StatusCode < 300 || resp.StatusCode > 399 || location == "" {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		next, err := resp.Request.URL.Parse(location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redirect from %s to %q: %w", link, location, err)
+		}
+		link = next.String()
+	}
+	return nil, fmt.Errorf("more than %d redirects from %s", l.maxRedirects(), landing.Hops[0].URL)
+}
+
+func (l *LandingPages) client() *http.Client {
+	if l.Client != nil {
+		return l.Client
+	}
+	return defaultLandingClient
+}
+
+// defaultLandingClient connects to public addresses only. It ignores any
+// proxy settings, since the address check must see the landing page host.
+var defaultLandingClient = func() *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, Control: PublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: 30 * time.Second, Transport: transport}
+}()
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which some
+// clouds use for their metadata services.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicOnly is a net.Dialer Control function refusing connections to
+// loopback, private, link-local, unspecified and other non-public addresses.
+// It runs on the resolved address of every connection, so a host name that
+// resolves to a private address is refused as well.
+func PublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	ip = ip.Unmap()
+
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+	return nil
+}
+
+func (l *LandingPages) userAgent() string {
+	if l.UserAgent != "" {
+		return l.UserAgent
+	}
+	return DefaultUserAgent
+}
+
+func (l *LandingPages) maxRedirects() int {
+	if l.MaxRedirects > 0 {
+		return l.MaxRedirects
+	}
+	return DefaultMaxRedirects
+}
+
+func (l *LandingPages) maxPageBytes() int64 {
+	if l.MaxPageBytes > 0 {
+		return l.MaxPageBytes
+	}
+	return DefaultMaxPageBytes
+}
+
+func isHTML(contentType string, body []byte) bool {
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+	return strings.Contains(contentType, "html")
+}
+
+// pageSummary returns the title and meta description of an HTML page.
+func pageSummary(body []byte) (title, description string) {
+	tokens := html.NewTokenizer(strings.NewReader(string(body)))
+	inTitle := false
+
+	for {
+		switch tokens.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(title), strings.TrimSpace(description)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokens.Token()
+			switch token.Data {
+			case "title":
+				inTitle = title == ""
+			case "meta":
+				if description == "" && isDescription(token) {
+					description = attr(token, "content")
+				}
+			case "body":
+				if title != "" && description != "" {
+					return strings.TrimSpace(title), strings.TrimSpace(description)
+				}
+			}
+
+		case html.TextToken:
+			if inTitle {
+				title += string(tokens.Text())
+			}
+
+		case html.EndTagToken:
+			if name, _ := tokens.TagName(); string(name) == "title" {
+				inTitle = false
+			}
+		}
+	}
+}
+
+func isDescription(token html.Token) bool {
+	name := strings.ToLower(attr(token, "name"))
+	property := strings.ToLower(attr(token, "property"))
+	return name == "description" || property == "og:description"
+}
+
+func attr(token html.Token, key string) string {
+	for _, a := range token.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
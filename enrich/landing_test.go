@@ -0,0 +1,229 @@
+package enrich
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+const landingHTML = `<!doctype html>
+<html><head>
+<title> Tênis Passada | Loja Oficial </title>
+<meta name="description" content="Frete grátis em todo o Brasil.">
+</head><body><h1>Passada</h1></body></html>`
+
+// snapshotRecorder is a SnapshotStore keeping what it was given.
+type snapshotRecorder struct {
+	saved []store.Snapshot
+	id    primitive.ObjectID
+}
+
+func (r *snapshotRecorder) SaveSnapshot(_ context.Context, snapshot store.Snapshot) (primitive.ObjectID, error) {
+	r.saved = append(r.saved, snapshot)
+	return r.id, nil
+}
+
+func newLandingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ad", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/track?id=42", http.StatusFound)
+	})
+	mux.HandleFunc("/track", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/landing", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/landing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		io.WriteString(w, landingHTML)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/to-metadata", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	})
+	mux.HandleFunc("/to-localhost", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://localhost:1/admin", http.StatusFound)
+	})
+	mux.HandleFunc("/to-file", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testClient reaches server, which listens on loopback, and applies
+// PublicOnly to every other address.
+func testClient(server *httptest.Server) *http.Client {
+	allowed := server.Listener.Addr().String()
+	dialer := &net.Dialer{Control: func(network, address string, c syscall.RawConn) error {
+		if address == allowed {
+			return nil
+		}
+		return PublicOnly(network, address, c)
+	}}
+	return &http.Client{Transport: &http.Transport{DialContext: dialer.DialContext}}
+}
+
+func adResult(link string) *store.Result {
+	result := store.NewResult(providers.SerpResult{Kind: providers.KindAd, Link: link})
+	return &result
+}
+
+func TestLandingPagesFollowsRedirects(t *testing.T) {
+	server := newLandingServer(t)
+	snapshots := &snapshotRecorder{id: primitive.NewObjectID()}
+	l := &LandingPages{Client: testClient(server), Snapshots: snapshots}
+
+	result := adResult(server.URL + "/ad")
+	if err := l.Enrich(context.Background(), "tenis", result); err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+
+	landing := result.Landing
+	wantHops := []store.Hop{
+		{URL: server.URL + "/ad", Status: http.StatusFound},
+		{URL: server.URL + "/track?id=42", Status: http.StatusMovedPermanently},
+		{URL: server.URL + "/landing", Status: http.StatusOK},
+	}
+	if len(landing.Hops) != len(wantHops) {
+		t.Fatalf("hops = %+v, want %+v", landing.Hops, wantHops)
+	}
+	for i, want := range wantHops {
+		if landing.Hops[i] != want {
+			t.Errorf("hop %d = %+v, want %+v", i, landing.Hops[i], want)
+		}
+	}
+
+	if landing.FinalURL != server.URL+"/landing" || landing.Status != http.StatusOK {
+		t.Errorf("final = %s %d, want %s/landing 200", landing.FinalURL, landing.Status, server.URL)
+	}
+	if landing.Title != "Tênis Passada | Loja Oficial" {
+		t.Errorf("Title = %q", landing.Title)
+	}
+	if landing.Description != "Frete grátis em todo o Brasil." {
+		t.Errorf("Description = %q", landing.Description)
+	}
+
+	sum := sha256.Sum256([]byte(landingHTML))
+	wantHash := hex.EncodeToString(sum[:])
+	if landing.ContentHash != wantHash {
+		t.Errorf("ContentHash = %s, want %s", landing.ContentHash, wantHash)
+	}
+	if landing.SnapshotID != snapshots.id {
+		t.Errorf("SnapshotID = %s, want %s", landing.SnapshotID.Hex(), snapshots.id.Hex())
+	}
+	if len(snapshots.saved) != 1 {
+		t.Fatalf("saved %d snapshots, want 1", len(snapshots.saved))
+	}
+	saved := snapshots.saved[0]
+	if string(saved.HTML) != landingHTML || saved.ContentHash != wantHash || saved.URL != landing.FinalURL || !saved.FetchedAt.Equal(landing.FetchedAt) {
+		t.Errorf("saved snapshot = {%s %s %s}, want the final page", saved.URL, saved.ContentHash, saved.FetchedAt)
+	}
+}
+
+func TestLandingPagesRedirectLimit(t *testing.T) {
+	server := newLandingServer(t)
+	snapshots := &snapshotRecorder{}
+	l := &LandingPages{Client: testClient(server), Snapshots: snapshots, MaxRedirects: 3}
+
+	result := adResult(server.URL + "/loop")
+	err := l.Enrich(context.Background(), "tenis", result)
+	if err == nil || !strings.Contains(err.Error(), "more than 3 redirects") {
+		t.Fatalf("Enrich error = %v, want the redirect limit", err)
+	}
+	if len(result.Landing.Hops) != 4 {
+		t.Errorf("recorded %d hops, want 4", len(result.Landing.Hops))
+	}
+	if len(snapshots.saved) != 0 {
+		t.Errorf("saved a snapshot for a failed fetch")
+	}
+}
+
+func TestLandingPagesRefusesUnsafeTargets(t *testing.T) {
+	server := newLandingServer(t)
+
+	tests := []struct {
+		name    string
+		link    string
+		client  *http.Client
+		blocked string
+		want    error
+	}{
+		{"redirect to metadata service", server.URL + "/to-metadata", testClient(server), "http://169.254.169.254/latest/meta-data/", ErrBlockedAddress},
+		{"redirect to localhost", server.URL + "/to-localhost", testClient(server), "http://localhost:1/admin", ErrBlockedAddress},
+		{"redirect to a file", server.URL + "/to-file", testClient(server), "file:///etc/passwd", nil},
+		{"loopback ad link with the default client", server.URL + "/landing", nil, server.URL + "/landing", ErrBlockedAddress},
+		{"private ad link", "http://10.0.0.1/", nil, "http://10.0.0.1/", ErrBlockedAddress},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshots := &snapshotRecorder{}
+			l := &LandingPages{Client: tt.client, Snapshots: snapshots}
+
+			result := adResult(tt.link)
+			err := l.Enrich(context.Background(), "tenis", result)
+			if err == nil || (tt.want != nil && !errors.Is(err, tt.want)) {
+				t.Fatalf("Enrich error = %v, want %v", err, tt.want)
+			}
+
+			hops := result.Landing.Hops
+			if len(hops) == 0 {
+				t.Fatal("no hop recorded")
+			}
+			last := hops[len(hops)-1]
+			if last.URL != tt.blocked || last.Status != 0 || last.Error == "" {
+				t.Errorf("last hop = %+v, want %s refused with an error", last, tt.blocked)
+			}
+			if len(snapshots.saved) != 0 {
+				t.Errorf("saved a snapshot of a refused page")
+			}
+		})
+	}
+}
+
+func TestPublicOnly(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:80", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:80", false},
+		{"[::1]:80", false},
+		{"0.0.0.0:80", false},
+		{"[::]:80", false},
+		{"10.1.2.3:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.1:443", false},
+		{"169.254.169.254:80", false},
+		{"[fe80::1]:80", false},
+		{"[fd00::1]:80", false},
+		{"100.100.100.200:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+		{"224.0.0.1:80", false},
+	}
+	for _, tt := range tests {
+		err := PublicOnly("tcp", tt.address, nil)
+		if (err == nil) != tt.allowed {
+			t.Errorf("PublicOnly(%s) = %v, want allowed %v", tt.address, err, tt.allowed)
+		}
+		if err != nil && !errors.Is(err, ErrBlockedAddress) {
+			t.Errorf("PublicOnly(%s) = %v, want ErrBlockedAddress", tt.address, err)
+		}
+	}
+}
@@ -2,7 +2,12 @@ module google-monitoring
 
 go 1.23.0
 
-require go.mongodb.org/mongo-driver v1.16.1
+require (
+	github.com/robfig/cron/v3 v3.0.1
+	go.mongodb.org/mongo-driver v1.16.1
+	golang.org/x/net v0.27.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	cloud.google.com/go/auth v0.8.1 // indirect
@@ -21,7 +26,6 @@ require (
 	go.opentelemetry.io/otel v1.24.0 // indirect
 	go.opentelemetry.io/otel/metric v1.24.0 // indirect
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
-	golang.org/x/net v0.27.0 // indirect
 	golang.org/x/oauth2 v0.22.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240730163845-b1a4ccb954bf // indirect
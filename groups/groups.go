@@ -0,0 +1,197 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/cities"
+)
+
+const groupsCollection = "city_groups"
+
+var ErrNotFound = errors.New("city group not found")
+
+// ValidationError reports an invalid group definition.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Group is a named, reusable set of cities, such as "capitais do Nordeste".
+type Group struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Tenant      string             `json:"-" bson:"tenant"`
+	Name        string             `json:"name" bson:"name"`
+	Description string             `json:"description" bson:"description"`
+	Cities      []string           `json:"cities" bson:"cities"`
+	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// Validate checks g and replaces its cities with their de-duplicated
+// catalogue locations.
+func (g *Group) Validate() error {
+	g.Name = strings.TrimSpace(g.Name)
+	if g.Name == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+	if cities.IsPresetName(g.Name) {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("%q is reserved for built-in presets", g.Name)}
+	}
+
+	canonical, unknown := cities.Normalize(g.Cities)
+	if len(unknown) > 0 {
+		return &ValidationError{Field: "cities", Message: "unknown cities: " + strings.Join(unknown, "; ")}
+	}
+	if len(canonical) == 0 {
+		return &ValidationError{Field: "cities", Message: "at least one city is required"}
+	}
+	g.Cities = canonical
+	return nil
+}
+
+// Store persists city groups in MongoDB. Every group belongs to a tenant,
+// and group names are unique within a tenant.
+type Store struct {
+	collection *mongo.Collection
+}
+
+func NewStore(client *mongo.Client, dbName string) *Store {
+	return &Store{collection: client.Database(dbName).Collection(groupsCollection)}
+}
+
+func (st *Store) EnsureIndexes(ctx context.Context) error {
+	// Names used to be unique across the whole deployment.
+	_, err := st.collection.Indexes().DropOne(ctx, "name_1")
+	var cmdErr mongo.CommandError
+	if err != nil && !(errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27)) {
+		return fmt.Errorf("failed to drop the name index on %s: %w", groupsCollection, err)
+	}
+
+	_, err = st.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tenant", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", groupsCollection, err)
+	}
+	return nil
+}
+
+func (st *Store) List(ctx context.Context, tenant string) ([]Group, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := st.collection.Find(ctx, bson.M{"tenant": tenant}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find city groups: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	list := []Group{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode city groups: %w", err)
+	}
+	return list, nil
+}
+
+func (st *Store) Get(ctx context.Context, tenant, name string) (*Group, error) {
+	var g Group
+	err := st.collection.FindOne(ctx, bson.M{"tenant": tenant, "name": name}).Decode(&g)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find city group %q: %w", name, err)
+	}
+	return &g, nil
+}
+
+func (st *Store) Create(ctx context.Context, tenant string, g *Group) error {
+	if err := g.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	g.ID = primitive.NewObjectID()
+	g.Tenant = tenant
+	g.CreatedAt = now
+	g.UpdatedAt = now
+
+	_, err := st.collection.InsertOne(ctx, g)
+	if mongo.IsDuplicateKeyError(err) {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("a group named %q already exists", g.Name)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert city group: %w", err)
+	}
+	return nil
+}
+
+// Update replaces the group called name with g, which may rename it.
+func (st *Store) Update(ctx context.Context, tenant, name string, g *Group) (*Group, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"name":        g.Name,
+		"description": g.Description,
+		"cities":      g.Cities,
+		"updated_at":  time.Now().UTC(),
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated Group
+	err := st.collection.FindOneAndUpdate(ctx, bson.M{"tenant": tenant, "name": name}, update, opts).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, &ValidationError{Field: "name", Message: fmt.Sprintf("a group named %q already exists", g.Name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update city group %q: %w", name, err)
+	}
+	return &updated, nil
+}
+
+func (st *Store) Delete(ctx context.Context, tenant, name string) error {
+	result, err := st.collection.DeleteOne(ctx, bson.M{"tenant": tenant, "name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete city group %q: %w", name, err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Resolve returns the city locations of a built-in preset or a group of
+// tenant.
+func (st *Store) Resolve(ctx context.Context, tenant, name string) ([]string, error) {
+	list, isPreset, err := cities.Preset(name)
+	if isPreset {
+		if err != nil {
+			return nil, &ValidationError{Field: "group", Message: err.Error()}
+		}
+		return cities.Locations(list), nil
+	}
+
+	g, err := st.Get(ctx, tenant, name)
+	if err != nil {
+		return nil, err
+	}
+	return g.Cities, nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"google-monitoring/store"
+)
+
+// AnalyticsHandler answers GET /analytics with the share of voice of every
+// advertiser shown for a query. Parameters:
+//
+//	query (required), city, device, from, to
+//
+// from and to take the same formats as the history filters; the window
+// defaults to the last 30 days.
+func AnalyticsHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		params := r.URL.Query()
+		q := store.AnalyticsQuery{
+			Tenant: tenantOf(r),
+			Query:  params.Get("query"),
+			City:   params.Get("city"),
+			Device: params.Get("device"),
+		}
+		if q.Query == "" {
+			http.Error(w, "query is required", http.StatusBadRequest)
+			return
+		}
+
+		var err error
+		if q.From, err = parseHistoryTime(params.Get("from"), false); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if q.To, err = parseHistoryTime(params.Get("to"), true); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !q.From.IsZero() && !q.To.IsZero() && q.From.After(q.To) {
+			http.Error(w, "from must not be after to", http.StatusBadRequest)
+			return
+		}
+
+		analytics, err := st.Analytics(r.Context(), q)
+		if err != nil {
+			fmt.Printf("Failed to compute analytics: %v\n", err)
+			http.Error(w, "Failed to compute analytics", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, analytics)
+	}
+}
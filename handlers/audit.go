@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google-monitoring/audit"
+)
+
+// AuditHandler answers GET /audit with the tenant's audit log, most recent
+// first. Parameters:
+//
+//	actor, method, path (prefix), from, to, limit
+func AuditHandler(log *audit.Log) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		params := r.URL.Query()
+		f := audit.Filter{
+			Tenant: tenantOf(r),
+			Actor:  params.Get("actor"),
+			Method: strings.ToUpper(params.Get("method")),
+			Path:   params.Get("path"),
+		}
+
+		var err error
+		if f.From, err = parseHistoryTime(params.Get("from"), false); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if f.To, err = parseHistoryTime(params.Get("to"), true); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if limit := params.Get("limit"); limit != "" {
+			f.Limit, err = strconv.Atoi(limit)
+			if err != nil || f.Limit <= 0 {
+				http.Error(w, fmt.Sprintf("invalid limit %q", limit), http.StatusBadRequest)
+				return
+			}
+		}
+
+		entries, err := log.Entries(r.Context(), f)
+		if err != nil {
+			fmt.Printf("Failed to list audit entries: %v\n", err)
+			http.Error(w, "Failed to list audit entries", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, entries)
+	}
+}
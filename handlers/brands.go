@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google-monitoring/brand"
+)
+
+// BrandsHandler lists (GET) and creates (POST) brand profiles.
+func BrandsHandler(brands *brand.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			list, err := brands.List(r.Context(), tenantOf(r))
+			if err != nil {
+				fmt.Printf("Failed to list brand profiles: %v\n", err)
+				http.Error(w, "Failed to list brand profiles", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, list)
+
+		case http.MethodPost:
+			var p brand.Profile
+			if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			if err := brands.Create(r.Context(), tenantOf(r), &p); err != nil {
+				writeBrandError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, p)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// BrandHandler reads (GET), replaces (PUT) and deletes (DELETE) the brand
+// profile at /brands/{name}.
+func BrandHandler(brands *brand.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+
+		switch r.Method {
+		case http.MethodGet:
+			p, err := brands.Get(r.Context(), tenantOf(r), name)
+			if err != nil {
+				writeBrandError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, p)
+
+		case http.MethodPut:
+			var p brand.Profile
+			if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			updated, err := brands.Update(r.Context(), tenantOf(r), name, &p)
+			if err != nil {
+				writeBrandError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, updated)
+
+		case http.MethodDelete:
+			if err := brands.Delete(r.Context(), tenantOf(r), name); err != nil {
+				writeBrandError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func writeBrandError(w http.ResponseWriter, err error) {
+	var validationErr *brand.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		http.Error(w, validationErr.Error(), http.StatusBadRequest)
+	case errors.Is(err, brand.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		fmt.Printf("Brand profile request failed: %v\n", err)
+		http.Error(w, "Failed to process brand profile", http.StatusInternalServerError)
+	}
+}
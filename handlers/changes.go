@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/store"
+)
+
+// ChangesHandler answers GET /changes with the ad changes detected between
+// runs, most recent first. Parameters:
+//
+//	query, city, advertiser, type, run_id, from, to, limit
+func ChangesHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		params := r.URL.Query()
+		f := store.ChangeFilter{
+			Tenant:     tenantOf(r),
+			Query:      params.Get("query"),
+			City:       params.Get("city"),
+			Advertiser: params.Get("advertiser"),
+			Type:       store.ChangeType(params.Get("type")),
+		}
+		if f.Type != "" && !store.ValidChangeType(f.Type) {
+			http.Error(w, fmt.Sprintf("invalid type %q", f.Type), http.StatusBadRequest)
+			return
+		}
+
+		if runID := params.Get("run_id"); runID != "" {
+			id, err := primitive.ObjectIDFromHex(runID)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid run_id %q", runID), http.StatusBadRequest)
+				return
+			}
+			f.RunID = id
+		}
+
+		var err error
+		if f.From, err = parseHistoryTime(params.Get("from"), false); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if f.To, err = parseHistoryTime(params.Get("to"), true); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if limit := params.Get("limit"); limit != "" {
+			f.Limit, err = strconv.Atoi(limit)
+			if err != nil || f.Limit <= 0 {
+				http.Error(w, fmt.Sprintf("invalid limit %q", limit), http.StatusBadRequest)
+				return
+			}
+		}
+
+		list, err := st.Changes(r.Context(), f)
+		if err != nil {
+			fmt.Printf("Failed to list ad changes: %v\n", err)
+			http.Error(w, "Failed to list ad changes", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, list)
+	}
+}
@@ -6,10 +6,17 @@ import (
 	"net/http"
 )
 
+// GetCities lists the catalogue, optionally filtered by state (?uf=SP) and by
+// a case- and accent-insensitive name fragment (?q=sao).
 func GetCities() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		uf := r.URL.Query().Get("uf")
+		if uf != "" && !cities.ValidUF(uf) {
+			http.Error(w, "Unknown uf", http.StatusBadRequest)
+			return
+		}
 
-		citiesList := cities.GetCities()
+		citiesList := cities.Filter(uf, r.URL.Query().Get("q"))
 
 		citiesJSON, err := json.Marshal(citiesList)
 		if err != nil {
@@ -20,4 +27,4 @@ func GetCities() http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		w.Write(citiesJSON)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google-monitoring/cities"
+	"google-monitoring/groups"
+)
+
+type GroupList struct {
+	Presets []cities.PresetInfo `json:"presets"`
+	Groups  []groups.Group      `json:"groups"`
+}
+
+// GroupsHandler lists the built-in presets and stored city groups (GET) and
+// creates a group (POST).
+func GroupsHandler(cityGroups *groups.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			list, err := cityGroups.List(r.Context(), tenantOf(r))
+			if err != nil {
+				fmt.Printf("Failed to list city groups: %v\n", err)
+				http.Error(w, "Failed to list city groups", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, GroupList{Presets: cities.Presets(), Groups: list})
+
+		case http.MethodPost:
+			var g groups.Group
+			if err := json.NewDecoder(r.Body).Decode(&g); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			if err := cityGroups.Create(r.Context(), tenantOf(r), &g); err != nil {
+				writeGroupError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, g)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// GroupHandler reads (GET), replaces (PUT) and deletes (DELETE) the group at
+// /groups/{name}. GET also resolves built-in presets such as "uf:SP".
+func GroupHandler(cityGroups *groups.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+
+		switch r.Method {
+		case http.MethodGet:
+			if cities.IsPresetName(name) {
+				locations, err := cityGroups.Resolve(r.Context(), tenantOf(r), name)
+				if err != nil {
+					writeGroupError(w, err)
+					return
+				}
+				writeJSON(w, http.StatusOK, groups.Group{Name: name, Cities: locations})
+				return
+			}
+
+			g, err := cityGroups.Get(r.Context(), tenantOf(r), name)
+			if err != nil {
+				writeGroupError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, g)
+
+		case http.MethodPut:
+			var g groups.Group
+			if err := json.NewDecoder(r.Body).Decode(&g); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			updated, err := cityGroups.Update(r.Context(), tenantOf(r), name, &g)
+			if err != nil {
+				writeGroupError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, updated)
+
+		case http.MethodDelete:
+			if err := cityGroups.Delete(r.Context(), tenantOf(r), name); err != nil {
+				writeGroupError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func writeGroupError(w http.ResponseWriter, err error) {
+	var validationErr *groups.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		http.Error(w, validationErr.Error(), http.StatusBadRequest)
+	case errors.Is(err, groups.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		fmt.Printf("City group request failed: %v\n", err)
+		http.Error(w, "Failed to process city group", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/store"
+)
+
+// parseHistoryQuery reads the GET /search filters:
+//
+//	query, city, device, advertiser, run_id, from, to, sort, limit, cursor,
+//	include_replays
+//
+// from and to accept RFC 3339 timestamps or plain dates; a plain date in
+// "to" covers the whole day.
+func parseHistoryQuery(r *http.Request) (store.HistoryQuery, error) {
+	params := r.URL.Query()
+
+	q := store.HistoryQuery{
+		Filter: store.HistoryFilter{
+			Tenant:           tenantOf(r),
+			Query:            params.Get("query"),
+			City:             params.Get("city"),
+			Device:           params.Get("device"),
+			AdvertiserDomain: params.Get("advertiser"),
+		},
+		Sort:   params.Get("sort"),
+		Cursor: params.Get("cursor"),
+	}
+
+	if runID := params.Get("run_id"); runID != "" {
+		id, err := primitive.ObjectIDFromHex(runID)
+		if err != nil {
+			return q, fmt.Errorf("invalid run_id %q", runID)
+		}
+		q.Filter.RunID = id
+	}
+
+	if replays := params.Get("include_replays"); replays != "" {
+		include, err := strconv.ParseBool(replays)
+		if err != nil {
+			return q, fmt.Errorf("invalid include_replays %q", replays)
+		}
+		q.Filter.IncludeReplays = include
+	}
+
+	var err error
+	if q.Filter.From, err = parseHistoryTime(params.Get("from"), false); err != nil {
+		return q, err
+	}
+	if q.Filter.To, err = parseHistoryTime(params.Get("to"), true); err != nil {
+		return q, err
+	}
+
+	if limit := params.Get("limit"); limit != "" {
+		q.Limit, err = strconv.Atoi(limit)
+		if err != nil || q.Limit <= 0 {
+			return q, fmt.Errorf("invalid limit %q", limit)
+		}
+	}
+
+	return q, nil
+}
+
+func parseHistoryTime(value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or RFC 3339", value)
+	}
+	if endOfDay {
+		return day.Add(24*time.Hour - time.Nanosecond), nil
+	}
+	return day, nil
+}
+
+func writeHistory(w http.ResponseWriter, r *http.Request, st *store.Store) {
+	q, err := parseHistoryQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := st.History(r.Context(), q)
+	if errors.Is(err, store.ErrInvalidHistoryQuery) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		fmt.Printf("Failed to retrieve search history: %v\n", err)
+		http.Error(w, "Failed to retrieve search results", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/jobs"
+	"google-monitoring/store"
+)
+
+// JobAccepted is the 202 answer of endpoints that start a background job.
+type JobAccepted struct {
+	JobID  string          `json:"job_id"`
+	Status store.RunStatus `json:"status"`
+	URL    string          `json:"url"`
+}
+
+// JobHandler reports the per-city progress of a job (GET) and cancels it
+// (DELETE) at /jobs/{id}.
+func JobHandler(runner *jobs.Runner) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+		if err != nil {
+			http.Error(w, "Invalid job id", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			job, err := runner.Get(r.Context(), tenantOf(r), id)
+			if err != nil {
+				writeJobError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, job)
+
+		case http.MethodDelete:
+			if err := runner.Cancel(r.Context(), tenantOf(r), id); err != nil {
+				writeJobError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func writeJobError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, jobs.ErrFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		fmt.Printf("Job request failed: %v\n", err)
+		http.Error(w, "Failed to process job", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/config"
+	"google-monitoring/jobs"
+	"google-monitoring/scheduler"
+)
+
+// SchedulesHandler lists (GET) and creates (POST) recurring monitoring jobs.
+// A schedule goes through the same checks as a search started by hand.
+func SchedulesHandler(schedules *scheduler.Store, runner *jobs.Runner, cfg *config.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			list, err := schedules.List(r.Context(), tenantOf(r))
+			if err != nil {
+				fmt.Printf("Failed to list schedules: %v\n", err)
+				http.Error(w, "Failed to list schedules", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, list)
+
+		case http.MethodPost:
+			var s scheduler.Schedule
+			if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			if err := checkSchedule(r, runner, cfg, &s); err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			if err := schedules.Create(r.Context(), tenantOf(r), &s); err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, s)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// ScheduleHandler reads (GET), replaces (PUT) and deletes (DELETE) the
+// schedule at /schedules/{id}.
+func ScheduleHandler(schedules *scheduler.Store, runner *jobs.Runner, cfg *config.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+		if err != nil {
+			http.Error(w, "Invalid schedule id", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			s, err := schedules.Get(r.Context(), tenantOf(r), id)
+			if err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, s)
+
+		case http.MethodPut:
+			var s scheduler.Schedule
+			if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			if err := checkSchedule(r, runner, cfg, &s); err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			updated, err := schedules.Update(r.Context(), tenantOf(r), id, &s)
+			if err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, updated)
+
+		case http.MethodDelete:
+			if err := schedules.Delete(r.Context(), tenantOf(r), id); err != nil {
+				writeScheduleError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// checkSchedule validates s and applies the checks of checkRunRequest to it,
+// so that a schedule that could never run is rejected up front.
+func checkSchedule(r *http.Request, runner *jobs.Runner, cfg *config.Config, s *scheduler.Schedule) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	err := checkRunRequest(r.Context(), runner, cfg, tenantOf(r), len(s.Cities), s.Brand)
+	var invalid *invalidRunError
+	if errors.As(err, &invalid) {
+		return &scheduler.ValidationError{Field: invalid.Field, Message: invalid.Message}
+	}
+	return err
+}
+
+func writeScheduleError(w http.ResponseWriter, err error) {
+	var validationErr *scheduler.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		http.Error(w, validationErr.Error(), http.StatusBadRequest)
+	case errors.Is(err, scheduler.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		fmt.Printf("Schedule request failed: %v\n", err)
+		http.Error(w, "Failed to process schedule", http.StatusInternalServerError)
+	}
+}
@@ -1,72 +1,67 @@
 package handlers
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"net/smtp"
-	"sync"
-
-	g "github.com/serpapi/google-search-results-golang"
-
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-
-	config "google-monitoring/config"
-
-	"google.golang.org/api/customsearch/v1"
-	"google.golang.org/api/googleapi/transport"
+	"strconv"
+	"strings"
+
+	"google-monitoring/access"
+	"google-monitoring/brand"
+	"google-monitoring/cities"
+	"google-monitoring/config"
+	"google-monitoring/enrich"
+	"google-monitoring/groups"
+	"google-monitoring/jobs"
+	"google-monitoring/monitor"
+	"google-monitoring/notify"
+	"google-monitoring/store"
+	"google-monitoring/tenants"
+	"google-monitoring/usage"
 )
 
-type AdResult struct {
-	Link string `json:"link"`
-}
-
 type SearchRequest struct {
-	City   string `json:"city"`
-	Query  string `json:"query"`
-	Device string `json:"device"`
+	City   string           `json:"city"`
+	Query  string           `json:"query"`
+	Device string           `json:"device"`
+	Email  string           `json:"email"`
+	Brand  string           `json:"brand"`
+	Enrich []string         `json:"enrich"`
+	Notify []notify.Channel `json:"notify"`
 }
 
-type TenCitiesSearchRequest struct {
-	Cities []string `json:"cities"`
-	Query  string   `json:"query"`
-	Device string   `json:"device"`
-	Email  string   `json:"email"`
+// MultiCitySearchRequest takes either Cities or the name of a city group or
+// preset in Group. Enrich names the enrichers to run, in order; leaving it
+// out runs all of them and an empty list none.
+type MultiCitySearchRequest struct {
+	Cities []string         `json:"cities"`
+	Group  string           `json:"group"`
+	Query  string           `json:"query"`
+	Device string           `json:"device"`
+	Email  string           `json:"email"`
+	Brand  string           `json:"brand"`
+	Enrich []string         `json:"enrich"`
+	Notify []notify.Channel `json:"notify"`
 }
 
-type SearchResult struct {
-	Title   string `json:"title"`
-	Snippet string `json:"snippet"`
-	Link    string `json:"link"`
+// SearchResponse answers a single-city search. Infringing stays empty when
+// no brand profile was given. Cached results are also reported through the
+// X-Cache and Age headers.
+type SearchResponse struct {
+	Results    []store.Result     `json:"results"`
+	Infringing []brand.Advertiser `json:"infringing"`
+	Cached     bool               `json:"cached"`
+	AgeSeconds int                `json:"age_seconds"`
 }
 
-func SearchHandler(client *mongo.Client) http.HandlerFunc {
+func SearchHandler(st *store.Store, runner *jobs.Runner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "GET" {
-			collection := client.Database(config.LoadConfig().DbName).Collection("searches")
-
-			filter := bson.M{}
-			opts := options.Find()
-
-			cursor, err := collection.Find(context.TODO(), filter, opts)
-			if err != nil {
-				http.Error(w, "Failed to retrieve search results", http.StatusInternalServerError)
-				return
-			}
-			defer cursor.Close(context.TODO())
-
-			var searchResults []SearchResult
-			if err := cursor.All(context.TODO(), &searchResults); err != nil {
-				http.Error(w, "Failed to decode search results", http.StatusInternalServerError)
-				return
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			json.NewEncoder(w).Encode(searchResults)
+			writeHistory(w, r, st)
 			return
 		}
 
@@ -77,263 +72,245 @@ func SearchHandler(client *mongo.Client) http.HandlerFunc {
 				return
 			}
 
-			parameter := map[string]string{
-				"engine":        "google",
-				"location":      req.City,
-				"q":             req.Query,
-				"google_domain": "google.com.br",
-				"gl":            "br",
-				"hl":            "pt-br",
-				"device":        req.Device,
+			// Like multi-city searches, only catalogue cities are looked up,
+			// under their SerpAPI location.
+			city, unknown := cities.Normalize([]string{req.City})
+			if len(unknown) > 0 {
+				http.Error(w, "Unknown city: "+unknown[0], http.StatusBadRequest)
+				return
 			}
+			if len(city) == 0 {
+				http.Error(w, "city is required", http.StatusBadRequest)
+				return
+			}
+			req.City = city[0]
 
-			apiKey := config.LoadConfig().SerpAPIKey
-
-			search := g.NewGoogleSearch(parameter, apiKey)
+			if !allowChannels(w, r, req.Notify) {
+				return
+			}
+			if err := validateChannels(req.Notify); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
 
-			results, err := search.GetJSON()
+			outcome, err := runner.Run(r.Context(), monitor.Request{
+				Query:     req.Query,
+				Cities:    []string{req.City},
+				Device:    req.Device,
+				Requester: requester(r, req.Email),
+				Brand:     req.Brand,
+				Enrichers: req.Enrich,
+				Notify:    req.Notify,
+				Tenant:    tenantOf(r),
+			})
 			if err != nil {
-				http.Error(w, "Failed to get search results", http.StatusInternalServerError)
+				writeStartError(w, err)
 				return
 			}
 
-			var adsOrOrganic interface{}
-			var ok bool
-
-			adsOrOrganic, ok = results["ads"]
-
-			if !ok {
-				adsOrOrganic, ok = results["organic_results"]
-				if !ok {
-					http.Error(w, "'ads' or 'organic_results' field not found in search results", http.StatusNotFound)
+			if err, failed := outcome.Errors[req.City]; failed {
+				if errors.Is(err, monitor.ErrNoResults) {
+					http.Error(w, err.Error(), http.StatusNotFound)
 					return
 				}
-			}
-
-			adsOrOrganicJSON, err := json.Marshal(adsOrOrganic)
-			if err != nil {
-				http.Error(w, "Failed to convert 'ads' or 'organic_results' to JSON", http.StatusInternalServerError)
+				http.Error(w, "Failed to get search results", http.StatusInternalServerError)
 				return
 			}
 
-			response, err := GoogleApiSearch(client, adsOrOrganicJSON, req.Query)
-			if err != nil {
-				http.Error(w, "Failed to get google api response", http.StatusInternalServerError)
+			w.Header().Set("X-Run-ID", outcome.Run.ID.Hex())
+			// The observation is missing only when the client went away.
+			observation := outcome.Observations[0]
+			if observation == nil {
 				return
 			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.Write(response)
+			if observation.Cached {
+				w.Header().Set("X-Cache", "HIT")
+				w.Header().Set("Age", strconv.Itoa(observation.AgeSeconds))
+			} else {
+				w.Header().Set("X-Cache", "MISS")
+			}
+			infringing := outcome.Run.Infringing
+			if infringing == nil {
+				infringing = []brand.Advertiser{}
+			}
+			writeJSON(w, http.StatusOK, SearchResponse{
+				Results:    outcome.Results(),
+				Infringing: infringing,
+				Cached:     observation.Cached,
+				AgeSeconds: observation.AgeSeconds,
+			})
 		}
 	}
 }
 
-func TenCitiesSearchHandler(client *mongo.Client) http.HandlerFunc {
+// MultiCitySearchHandler starts a multi-city run in the background and answers
+// 202 with the job to follow through /jobs/{id}. The requested cities are
+// de-duplicated and must belong to the catalogue; their number must lie
+// within the configured bounds and the tenant's search budget.
+func MultiCitySearchHandler(runner *jobs.Runner, cityGroups *groups.Store, cfg *config.Config) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		var req TenCitiesSearchRequest
+		var req MultiCitySearchRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			http.Error(w, "Invalid request payload", http.StatusBadRequest)
 			return
 		}
 
-		if len(req.Cities) != 10 {
-			http.Error(w, "Exactly 10 cities must be provided", http.StatusBadRequest)
-			return
-		}
-
-		apiKey := config.LoadConfig().SerpAPIKey
-		mongoCollection := client.Database(config.LoadConfig().DbName).Collection("searches")
-
-		var allResults []SearchResult
-		var emailBody bytes.Buffer
-		var mu sync.Mutex
-		var wg sync.WaitGroup
+		if req.Group != "" {
+			if len(req.Cities) > 0 {
+				http.Error(w, "Provide either cities or group, not both", http.StatusBadRequest)
+				return
+			}
 
-		cityChan := make(chan string, len(req.Cities))
-		for _, city := range req.Cities {
-			cityChan <- city
+			groupCities, err := cityGroups.Resolve(r.Context(), tenantOf(r), req.Group)
+			if err != nil {
+				writeGroupError(w, err)
+				return
+			}
+			req.Cities = groupCities
 		}
-		close(cityChan)
-
-		numWorkers := 3 
-
-		searchLimit := 20
-		searchCounter := 0
-
-		for i := 0; i < numWorkers; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-
-				for city := range cityChan {
-					mu.Lock()
-					if searchCounter >= searchLimit {
-						mu.Unlock()
-						fmt.Printf("Search limit of %d reached, skipping further searches.\n", searchLimit)
-						break
-					}
-					searchCounter++
-					mu.Unlock()
-
-					parameter := map[string]string{
-						"engine":        "google",
-						"location":      city,
-						"q":             req.Query,
-						"google_domain": "google.com.br",
-						"gl":            "br",
-						"hl":            "pt-br",
-						"device":        req.Device,
-					}
-
-					search := g.NewGoogleSearch(parameter, apiKey)
-					results, err := search.GetJSON()
-					if err != nil {
-						fmt.Printf("Failed to get search results for city %s: %v\n", city, err)
-						continue
-					}
 
-					adsOrOrganic, ok := results["ads"]
-					if !ok {
-						adsOrOrganic, ok = results["organic_results"]
-						if !ok {
-							fmt.Printf("'ads' or 'organic_results' field not found for city %s\n", city)
-							continue
-						}
-					}
-
-					adsOrOrganicJSON, err := json.Marshal(adsOrOrganic)
-					if err != nil {
-						fmt.Printf("Failed to convert 'ads' or 'organic_results' to JSON for city %s: %v\n", city, err)
-						continue
-					}
-
-					siteData, err := GoogleApiSearch(client, adsOrOrganicJSON, req.Query)
-					if err != nil {
-						fmt.Printf("Failed to get google api response for city %s: %v\n", city, err)
-						continue
-					}
-
-					mu.Lock()
-					var searchResults []SearchResult
-					if err := json.Unmarshal(siteData, &searchResults); err != nil {
-						fmt.Printf("Failed to unmarshal search results for city %s: %v\n", city, err)
-						mu.Unlock()
-						continue
-					}
-
-					for _, result := range searchResults {
-						_, err := mongoCollection.InsertOne(context.TODO(), result)
-						if err != nil {
-							fmt.Printf("Failed to insert search result into MongoDB for city %s: %s\n", city, err.Error())
-						}
-
-						emailBody.WriteString(fmt.Sprintf("Cidade: %s\nTítulo: %s\nDesrição: %s\nLink: %s\n\n", city, result.Title, result.Snippet, result.Link))
-					}
-
-					allResults = append(allResults, searchResults...)
-					mu.Unlock()
-				}
-			}()
+		requestedCities, unknown := cities.Normalize(req.Cities)
+		if len(unknown) > 0 {
+			http.Error(w, "Unknown cities: "+strings.Join(unknown, "; "), http.StatusBadRequest)
+			return
 		}
 
-		wg.Wait()
+		tenant := tenantOf(r)
+		if err := checkRunRequest(r.Context(), runner, cfg, tenant, len(requestedCities), req.Brand); err != nil {
+			writeStartError(w, err)
+			return
+		}
 
-		go func() {
-			if err := SendEmail(req.Email, emailBody.String()); err != nil {
-				fmt.Printf("Failed to send email: %v\n", err)
-			}
-		}()
+		// Email is the original way of asking for the report and still works,
+		// for analysts too.
+		if !allowChannels(w, r, req.Notify) {
+			return
+		}
+		channels := append(notify.EmailChannels(req.Email), req.Notify...)
+		if err := validateChannels(channels); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 
-		// Return the combined results as a JSON response
-		resultsJSON, err := json.Marshal(allResults)
+		run, err := runner.Start(monitor.Request{
+			Query:     req.Query,
+			Cities:    requestedCities,
+			Device:    req.Device,
+			Requester: requester(r, req.Email),
+			Brand:     req.Brand,
+			Enrichers: req.Enrich,
+			Notify:    channels,
+			Tenant:    tenant,
+		})
 		if err != nil {
-			http.Error(w, "Failed to marshal combined search results", http.StatusInternalServerError)
+			writeStartError(w, err)
 			return
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(resultsJSON)
+		jobURL := "/jobs/" + run.ID.Hex()
+		w.Header().Set("Location", jobURL)
+		writeJSON(w, http.StatusAccepted, JobAccepted{
+			JobID:  run.ID.Hex(),
+			Status: run.Status,
+			URL:    jobURL,
+		})
 	}
 }
 
-func GoogleApiSearch(mongoClient *mongo.Client, adsOrOrganicJSON []byte, query string) ([]byte, error) {
-	apiKey := config.LoadConfig().CustomSearchAPIKey
-	cx := config.LoadConfig().SearchEngineID
+// invalidRunError reports a run request that breaks one of the limits
+// checked by checkRunRequest. Message is meant for the client.
+type invalidRunError struct {
+	Field   string
+	Message string
+}
 
-	client := &http.Client{Transport: &transport.APIKey{Key: apiKey}}
+func (e *invalidRunError) Error() string {
+	return e.Message
+}
 
-	svc, err := customsearch.New(client)
-	if err != nil {
-		return nil, err
+// checkRunRequest applies the checks every run goes through before it is
+// started or scheduled: its number of cities must lie within the configured
+// bounds and the tenant's search budget, and its brand profile, when named,
+// must exist.
+func checkRunRequest(ctx context.Context, runner *jobs.Runner, cfg *config.Config, tenant string, cityCount int, brandName string) error {
+	if cityCount < cfg.MinCities || cityCount > cfg.MaxCities {
+		return &invalidRunError{Field: "cities", Message: fmt.Sprintf("Between %d and %d distinct cities must be provided", cfg.MinCities, cfg.MaxCities)}
 	}
-
-	var linkResults []AdResult
-	if err := json.Unmarshal(adsOrOrganicJSON, &linkResults); err != nil {
-		fmt.Printf("Failed to unmarshal ads JSON: %s\n", err.Error())
-		return nil, err
+	if limit := runner.SearchLimit(tenant); cityCount > limit {
+		return &invalidRunError{Field: "cities", Message: fmt.Sprintf("%d cities requested but the search budget is %d per request", cityCount, limit)}
 	}
 
-	var searchResults []SearchResult
-
-	mongoCollection := mongoClient.Database(config.LoadConfig().DbName).Collection("searches")
-
-	for _, result := range linkResults {
-		linkSite := result.Link
-
-		resp, err := svc.Cse.List().Cx(cx).Q(query).SiteSearch(linkSite).Do()
-		if err != nil {
-			fmt.Printf(err.Error())
-		}
-
-		if len(resp.Items) > 0 {
-			item := resp.Items[0]
-
-			searchResult := SearchResult{
-				Title:   item.Title,
-				Snippet: item.Snippet,
-				Link:    item.Link,
-			}
-
-			searchResults = append(searchResults, searchResult)
-
-			_, err := mongoCollection.InsertOne(context.TODO(), searchResult)
-			if err != nil {
-				fmt.Printf("Failed to insert search result into MongoDB: %s\n", err.Error())
-			}
-		}
+	_, err := runner.Profile(ctx, tenant, brandName)
+	if errors.Is(err, brand.ErrNotFound) {
+		return &invalidRunError{Field: "brand", Message: "Unknown brand profile"}
 	}
+	return err
+}
 
-	resultsJSON, err := json.Marshal(searchResults)
-	if err != nil {
-		return nil, err
+// writeStartError answers a run that could not be started.
+func writeStartError(w http.ResponseWriter, err error) {
+	var invalid *invalidRunError
+	if errors.As(err, &invalid) {
+		http.Error(w, invalid.Message, http.StatusBadRequest)
+		return
 	}
-
-	return resultsJSON, nil
+	if errors.Is(err, brand.ErrNotFound) {
+		http.Error(w, "Unknown brand profile", http.StatusBadRequest)
+		return
+	}
+	var budgetErr *usage.BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		writeBudgetExceeded(w, budgetErr)
+		return
+	}
+	var unknownEnricher *enrich.UnknownEnricherError
+	if errors.As(err, &unknownEnricher) {
+		http.Error(w, unknownEnricher.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Printf("Failed to start run: %v\n", err)
+	http.Error(w, "Failed to start monitoring run", http.StatusInternalServerError)
 }
 
-func SendEmail(to, body string) error {
-	from := config.LoadConfig().MailFrom
-	password := config.LoadConfig().MailPassword
+// allowChannels answers 403 and returns false when the caller may not send
+// reports to channels. Only admins manage notification channels.
+func allowChannels(w http.ResponseWriter, r *http.Request, channels []notify.Channel) bool {
+	if len(channels) == 0 || access.Can(access.FromContext(r.Context()).Role, access.ManageNotifications) {
+		return true
+	}
+	http.Error(w, "Forbidden: "+string(access.ManageNotifications)+" permission required", http.StatusForbidden)
+	return false
+}
 
-	// Set up authentication information.
-	auth := smtp.PlainAuth("", from, password, "smtp.gmail.com")
+func validateChannels(channels []notify.Channel) error {
+	for _, c := range channels {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// Create the email message.
-	msg := "From: " + from + "\n" +
-		"To: " + to + "\n" +
-		"Subject: Monitoramente Brand | Resultados\n\n" +
-		body
+// tenantOf returns the tenant a request is made on behalf of, as
+// authenticated by middleware.Auth.
+func tenantOf(r *http.Request) string {
+	return tenants.NameFromContext(r.Context())
+}
 
-	// Send the email.
-	err := smtp.SendMail("smtp.gmail.com:587", auth, from, []string{to}, []byte(msg))
+// requester identifies who asked for a run: the notification email when one
+// was given, the client address otherwise.
+func requester(r *http.Request, email string) string {
+	if email != "" {
+		return email
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
+		return r.RemoteAddr
 	}
-
-	return nil
+	return host
 }
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/store"
+)
+
+// SnapshotHandler serves the stored HTML of a landing page. The page is sent
+// as a download in a sandbox so its scripts never run on this origin.
+func SnapshotHandler(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+		if err != nil {
+			http.Error(w, "Invalid snapshot id", http.StatusBadRequest)
+			return
+		}
+
+		snapshot, err := st.Snapshot(r.Context(), tenantOf(r), id)
+		if errors.Is(err, store.ErrSnapshotNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			fmt.Printf("Failed to load snapshot: %v\n", err)
+			http.Error(w, "Failed to load snapshot", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", snapshot.ContentHash+".html"))
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("X-Snapshot-URL", snapshot.URL)
+		w.Header().Set("X-Content-Hash", snapshot.ContentHash)
+		w.Write(snapshot.HTML)
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google-monitoring/access"
+	"google-monitoring/usage"
+)
+
+// UsageHandler answers GET /usage with the credits the tenant spent, by day
+// and by job, and the state of its budgets. from and to take the same formats
+// as the history filters and default to the current month.
+func UsageHandler(ledger *usage.Ledger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		params := r.URL.Query()
+		from, err := parseHistoryTime(params.Get("from"), false)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		to, err := parseHistoryTime(params.Get("to"), true)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		now := time.Now().UTC()
+		if to.IsZero() {
+			to = now
+		}
+		if from.IsZero() {
+			from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		}
+		if from.After(to) {
+			http.Error(w, "from must not be after to", http.StatusBadRequest)
+			return
+		}
+
+		report, err := ledger.Report(r.Context(), tenantOf(r), from, to)
+		if err != nil {
+			fmt.Printf("Failed to report usage: %v\n", err)
+			http.Error(w, "Failed to report usage", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, report)
+	}
+}
+
+// BudgetHandler reads (GET) and overrides (PUT) the credit budgets of the
+// tenant at /usage/budget. A null or missing period falls back to the
+// configured budget; zero makes it unlimited. Overrides may not go over the
+// configured budgets, nor make them unlimited.
+func BudgetHandler(ledger *usage.Ledger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			settings, err := ledger.Budget(r.Context(), tenantOf(r))
+			if err != nil {
+				fmt.Printf("Failed to read budget: %v\n", err)
+				http.Error(w, "Failed to read budget", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, settings)
+
+		case http.MethodPut:
+			var budget usage.Budget
+			if err := json.NewDecoder(r.Body).Decode(&budget); err != nil {
+				http.Error(w, "Invalid request payload", http.StatusBadRequest)
+				return
+			}
+
+			settings, err := ledger.SetBudget(r.Context(), tenantOf(r), budget, access.FromContext(r.Context()).Actor)
+			if errors.Is(err, usage.ErrInvalidBudget) || errors.Is(err, usage.ErrBudgetAboveCeiling) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err != nil {
+				fmt.Printf("Failed to update budget: %v\n", err)
+				http.Error(w, "Failed to update budget", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, settings)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// writeBudgetExceeded answers 429 with the time the budget resets.
+func writeBudgetExceeded(w http.ResponseWriter, err *usage.BudgetExceededError) {
+	retryAfter := math.Ceil(time.Until(err.ResetAt).Seconds())
+	w.Header().Set("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+	http.Error(w, err.Error(), http.StatusTooManyRequests)
+}
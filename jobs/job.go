@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/brand"
+	"google-monitoring/monitor"
+	"google-monitoring/store"
+)
+
+// City statuses for cities without an observation yet.
+const (
+	CityPending = "pending"
+	CityNotRun  = "not_run"
+)
+
+// Job is the progress of a run as exposed by GET /jobs/{id}.
+type Job struct {
+	ID         primitive.ObjectID `json:"id"`
+	Query      string             `json:"query"`
+	Device     string             `json:"device"`
+	Status     store.RunStatus    `json:"status"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt *time.Time         `json:"finished_at,omitempty"`
+	Total      int                `json:"total"`
+	Done       int                `json:"done"`
+	// Infringing lists the infringing advertisers found so far.
+	Infringing []brand.Advertiser `json:"infringing"`
+	// Advertisers groups the results so far by advertiser domain.
+	Advertisers []monitor.Advertiser `json:"advertisers"`
+	Cities      []CityProgress       `json:"cities"`
+}
+
+type CityProgress struct {
+	City       string         `json:"city"`
+	Status     string         `json:"status"`
+	Error      string         `json:"error,omitempty"`
+	Cached     bool           `json:"cached,omitempty"`
+	AgeSeconds int            `json:"age_seconds,omitempty"`
+	Results    []store.Result `json:"results,omitempty"`
+}
+
+// Get returns the progress of the job of tenant with id, including the
+// results of every city finished so far.
+func (r *Runner) Get(ctx context.Context, tenant string, id primitive.ObjectID) (*Job, error) {
+	run, err := r.run(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+
+	observations, err := r.store.ObservationsForRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	byCity := map[string][]store.Observation{}
+	observed := make([]*store.Observation, 0, len(observations))
+	for i, observation := range observations {
+		byCity[observation.City] = append(byCity[observation.City], observation)
+		observed = append(observed, &observations[i])
+	}
+
+	job := &Job{
+		ID:          run.ID,
+		Query:       run.Query,
+		Device:      run.Device,
+		Status:      run.Status,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+		Total:       len(run.Cities),
+		Infringing:  monitor.Infringing(observed),
+		Advertisers: monitor.Advertisers(observed),
+		Cities:      make([]CityProgress, 0, len(run.Cities)),
+	}
+
+	for _, city := range run.Cities {
+		progress := CityProgress{City: city, Status: CityPending}
+		if run.Finished() {
+			progress.Status = CityNotRun
+		}
+
+		if pending := byCity[city]; len(pending) > 0 {
+			observation := pending[0]
+			byCity[city] = pending[1:]
+
+			progress.Status = string(observation.Status)
+			progress.Error = observation.Error
+			progress.Cached = observation.Cached
+			progress.AgeSeconds = observation.AgeSeconds
+			progress.Results = observation.Results
+			job.Done++
+		}
+
+		job.Cities = append(job.Cities, progress)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,311 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/brand"
+	"google-monitoring/monitor"
+	"google-monitoring/providers"
+	"google-monitoring/store"
+	"google-monitoring/usage"
+)
+
+const numWorkers = 3
+
+var (
+	ErrNotFound = errors.New("job not found")
+	ErrFinished = errors.New("job already finished")
+)
+
+// Quota returns how many SERP lookups a single job of tenant may spend.
+type Quota func(tenant string) int
+
+// Runner executes monitoring requests over their cities with a small worker
+// pool. A job is identified by the ID of the run it stores, so its progress
+// can be read back by any instance.
+type Runner struct {
+	store   *store.Store
+	monitor *monitor.Monitor
+	quota   Quota
+	ledger  *usage.Ledger
+
+	mu      sync.Mutex
+	running map[primitive.ObjectID]context.CancelFunc
+}
+
+// NewRunner returns a runner charging the credits it spends to ledger; a nil
+// ledger disables credit accounting and budgets.
+func NewRunner(st *store.Store, m *monitor.Monitor, quota Quota, ledger *usage.Ledger) *Runner {
+	return &Runner{
+		store:   st,
+		monitor: m,
+		quota:   quota,
+		ledger:  ledger,
+		running: map[primitive.ObjectID]context.CancelFunc{},
+	}
+}
+
+// SearchLimit returns the search budget of a single job of tenant.
+func (r *Runner) SearchLimit(tenant string) int {
+	return r.quota(tenant)
+}
+
+// Profile loads the brand profile of tenant called name; it returns nil when
+// name is empty.
+func (r *Runner) Profile(ctx context.Context, tenant, name string) (*brand.Profile, error) {
+	return r.monitor.Profile(ctx, tenant, name)
+}
+
+// CheckBudget returns a *usage.BudgetExceededError when the tenant of req
+// cannot afford the lookups it needs. Lookups the SERP cache can answer are
+// free, so they are only counted when the budget falls short.
+func (r *Runner) CheckBudget(ctx context.Context, req monitor.Request) error {
+	if r.ledger == nil {
+		return nil
+	}
+	limit := r.SearchLimit(req.Tenant)
+	err := r.ledger.Check(ctx, req.Tenant, min(len(req.Cities), limit))
+	var budgetErr *usage.BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		return r.ledger.Check(ctx, req.Tenant, min(r.monitor.Uncached(ctx, req), limit))
+	}
+	return err
+}
+
+// Start stores the run for req and executes it in the background.
+func (r *Runner) Start(req monitor.Request) (*store.Run, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := r.CheckBudget(ctx, req); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	profile, err := r.monitor.Profile(ctx, req.Tenant, req.Brand)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	run, err := r.monitor.Start(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	r.track(run.ID, cancel)
+	go func() {
+		defer r.untrack(run.ID)
+		r.execute(ctx, run, profile, req)
+	}()
+
+	return run, nil
+}
+
+// Run executes req and waits for it to finish.
+func (r *Runner) Run(ctx context.Context, req monitor.Request) (*monitor.Outcome, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	if err := r.CheckBudget(ctx, req); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	profile, err := r.monitor.Profile(ctx, req.Tenant, req.Brand)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	run, err := r.monitor.Start(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	r.track(run.ID, cancel)
+	defer r.untrack(run.ID)
+
+	return r.execute(ctx, run, profile, req), nil
+}
+
+// Cancel stops a running job of tenant. When the job runs on another instance
+// the cancellation is recorded on the run and picked up there before the next
+// city.
+func (r *Runner) Cancel(ctx context.Context, tenant string, id primitive.ObjectID) error {
+	if _, err := r.run(ctx, tenant, id); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	cancel, local := r.running[id]
+	r.mu.Unlock()
+
+	if local {
+		cancel()
+		return nil
+	}
+
+	requested, err := r.store.RequestCancel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if requested {
+		return nil
+	}
+	return ErrFinished
+}
+
+// run loads the run with id, which must belong to tenant. Runs of other
+// tenants are reported as not found.
+func (r *Runner) run(ctx context.Context, tenant string, id primitive.ObjectID) (*store.Run, error) {
+	run, err := r.store.GetRun(ctx, id)
+	if errors.Is(err, store.ErrRunNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if run.Tenant != tenant {
+		return nil, ErrNotFound
+	}
+	return run, nil
+}
+
+func (r *Runner) track(id primitive.ObjectID, cancel context.CancelFunc) {
+	r.mu.Lock()
+	r.running[id] = cancel
+	r.mu.Unlock()
+}
+
+func (r *Runner) untrack(id primitive.ObjectID) {
+	r.mu.Lock()
+	cancel := r.running[id]
+	delete(r.running, id)
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (r *Runner) execute(ctx context.Context, run *store.Run, profile *brand.Profile, req monitor.Request) *monitor.Outcome {
+	outcome := &monitor.Outcome{
+		Run:          run,
+		Observations: make([]*store.Observation, len(req.Cities)),
+		Errors:       map[string]error{},
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	cityChan := make(chan int, len(req.Cities))
+	for i := range req.Cities {
+		cityChan <- i
+	}
+	close(cityChan)
+
+	searchLimit := r.SearchLimit(req.Tenant)
+	searchCounter := 0
+
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range cityChan {
+				if r.cancelled(ctx, run) {
+					return
+				}
+
+				city := req.Cities[index]
+
+				mu.Lock()
+				limitReached := searchCounter >= searchLimit
+				if !limitReached {
+					searchCounter++
+				}
+				mu.Unlock()
+
+				var observation *store.Observation
+				var err error
+				if limitReached {
+					fmt.Printf("Search limit of %d reached, skipping city %s.\n", searchLimit, city)
+					err = fmt.Errorf("search limit of %d reached", searchLimit)
+					observation = r.monitor.Skip(ctx, run, city, err.Error())
+				} else {
+					observation, err = r.observe(ctx, run, profile, city)
+				}
+
+				mu.Lock()
+				outcome.Observations[index] = observation
+				if err != nil {
+					outcome.Errors[city] = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	r.monitor.Finish(ctx, outcome, req.Notify)
+	return outcome
+}
+
+// observe looks city up with a credit reserved from the budgets of the
+// tenant of run; other jobs of the tenant may have spent them since the run
+// was accepted. Without credits left, the city is answered from the SERP
+// cache when it can be and skipped otherwise.
+func (r *Runner) observe(ctx context.Context, run *store.Run, profile *brand.Profile, city string) (*store.Observation, error) {
+	if r.ledger == nil {
+		return r.monitor.Observe(ctx, run, profile, city)
+	}
+
+	reservation, err := r.ledger.Reserve(ctx, run.Tenant, 1)
+	var budgetErr *usage.BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		observation, cacheErr := r.monitor.ObserveCached(ctx, run, profile, city)
+		if !errors.Is(cacheErr, providers.ErrNotCached) {
+			return observation, cacheErr
+		}
+	}
+	if err != nil {
+		fmt.Printf("Skipping city %s: %v\n", city, err)
+		return r.monitor.Skip(ctx, run, city, err.Error()), err
+	}
+
+	observation, err := r.monitor.Observe(ctx, run, profile, city)
+	if settleErr := r.ledger.Settle(context.WithoutCancel(ctx), reservation, run.ID, observation.Credits); settleErr != nil {
+		fmt.Printf("Failed to record credits: %v\n", settleErr)
+	}
+	return observation, err
+}
+
+// cancelled reports whether the job should stop, checking for cancellations
+// requested through other instances as well.
+func (r *Runner) cancelled(ctx context.Context, run *store.Run) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	requested, err := r.store.CancelRequested(ctx, run.ID)
+	if err != nil {
+		fmt.Printf("Failed to check cancellation of run %s: %v\n", run.ID.Hex(), err)
+		return false
+	}
+	if requested {
+		r.mu.Lock()
+		cancel := r.running[run.ID]
+		r.mu.Unlock()
+		if cancel != nil {
+			cancel()
+		}
+	}
+	return requested
+}
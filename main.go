@@ -5,19 +5,46 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
+	"google-monitoring/access"
+	"google-monitoring/audit"
+	"google-monitoring/brand"
 	"google-monitoring/config"
+	"google-monitoring/enrich"
+	"google-monitoring/groups"
+	"google-monitoring/jobs"
 	"google-monitoring/middleware"
+	"google-monitoring/monitor"
+	"google-monitoring/notify"
+	"google-monitoring/providers"
+	"google-monitoring/ratelimit"
+	"google-monitoring/report"
+	"google-monitoring/scheduler"
+	"google-monitoring/serpcache"
+	"google-monitoring/store"
+	"google-monitoring/tenants"
+	"google-monitoring/usage"
 
 	"google-monitoring/handlers"
 )
 
 func main() {
-	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(config.LoadConfig().MongoURI))
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	fmt.Printf("Configuration: %s\n", cfg)
+
+	clientOptions := options.Client().
+		ApplyURI(cfg.MongoURI).
+		SetConnectTimeout(cfg.MongoTimeout).
+		SetServerSelectionTimeout(cfg.MongoTimeout)
+	client, err := mongo.Connect(context.TODO(), clientOptions)
 	if err != nil {
 		panic(err)
 	}
@@ -30,13 +57,203 @@ func main() {
 	}
 	fmt.Println("Connected to MongoDB!")
 
-    mux := http.NewServeMux()
+	st := store.New(client, cfg.DbName)
+	if err := st.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	schedules := scheduler.NewStore(client, cfg.DbName)
+	if err := schedules.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	cityGroups := groups.NewStore(client, cfg.DbName)
+	if err := cityGroups.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	tenantStore := tenants.NewStore(client, cfg.DbName)
+	if err := tenantStore.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	auditLog := audit.NewLog(client, cfg.DbName)
+	if err := auditLog.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	brands := brand.NewStore(client, cfg.DbName)
+	if err := brands.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	ledger := usage.NewLedger(client, cfg.DbName, usage.Budgets{
+		Daily:         cfg.DailyCreditBudget,
+		Monthly:       cfg.MonthlyCreditBudget,
+		TenantDaily:   cfg.TenantDailyCreditBudgets,
+		TenantMonthly: cfg.TenantMonthlyCreditBudgets,
+	})
+	if err := ledger.EnsureIndexes(context.TODO()); err != nil {
+		log.Fatal(err)
+	}
+
+	serpProvider, err := newSerpProvider(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if cfg.SerpCacheTTL > 0 {
+		var cache serpcache.Tiered
+		cache = append(cache, serpcache.NewMemory(cfg.SerpCacheSize))
+		if cfg.SerpCacheMongo {
+			shared := serpcache.NewMongo(client, cfg.DbName, cfg.SerpCacheTTL)
+			if err := shared.EnsureIndexes(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			cache = append(cache, shared)
+		}
+		serpProvider = &providers.CachingProvider{Provider: serpProvider, Cache: cache, TTL: cfg.SerpCacheTTL}
+	}
+
+	notifier := &notify.Dispatcher{
+		SMTP: notify.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.MailPassword,
+			From:     cfg.MailFrom,
+			TLS:      cfg.SMTPTLS,
+		},
+		WebhookSecret: cfg.WebhookSecret,
+		Telegram: notify.TelegramConfig{
+			Token:  cfg.TelegramBotToken,
+			APIURL: cfg.TelegramAPIURL,
+		},
+	}
+
+	enrichers, err := enrich.FromConfig(cfg, st)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	reports, err := report.NewRenderer(cfg.ReportLocale, cfg.ReportSubject)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	runner := jobs.NewRunner(st, monitor.New(st, serpProvider, brands, enrichers, notifier, reports), cfg.SearchLimitFor, ledger)
+
+	go scheduler.NewWorker(schedules, runner, cfg.SchedulerPollInterval, cfg.SchedulerWorkers).Run(context.Background())
 
-    mux.HandleFunc("/cities", handlers.GetCities())
-    mux.HandleFunc("/search", handlers.SearchHandler(client))
-	mux.HandleFunc("/search/ten-cities", handlers.TenCitiesSearchHandler(client))
+	mux := http.NewServeMux()
 
-    corsHandler := middleware.CORS(mux)
+	read := middleware.Methods{http.MethodGet: access.ReadData}
+	search := middleware.Methods{http.MethodPost: access.RunSearches}
 
-    log.Fatal(http.ListenAndServe(":8080", corsHandler))
-}
\ No newline at end of file
+	var buckets middleware.BucketStore = ratelimit.NewMemory()
+	if cfg.RateLimitsShared {
+		shared := ratelimit.NewMongo(client, cfg.DbName)
+		if err := shared.EnsureIndexes(context.TODO()); err != nil {
+			log.Fatal(err)
+		}
+		buckets = shared
+	}
+	limits := middleware.RateLimits{"": middleware.Limit(cfg.RateLimit)}
+	// Starting a search spends SerpAPI credits, so every search route draws
+	// from one stricter bucket.
+	searchLimits := middleware.RateLimits{"": middleware.Limit(cfg.RateLimit), http.MethodPost: middleware.Limit(cfg.SearchRateLimit)}
+
+	route := func(pattern string, methods middleware.Methods, handler http.Handler) {
+		bucket, routeLimits := pattern, limits
+		if strings.HasPrefix(pattern, "/search") {
+			bucket, routeLimits = "/search", searchLimits
+		}
+		mux.Handle(pattern, middleware.RateLimit(buckets, bucket, routeLimits, middleware.Require(methods, handler)))
+	}
+
+	route("/cities", read, handlers.GetCities())
+	route("/search", middleware.Methods{
+		http.MethodGet:  access.ReadData,
+		http.MethodPost: access.RunSearches,
+	}, handlers.SearchHandler(st, runner))
+	route("/search/cities", search, handlers.MultiCitySearchHandler(runner, cityGroups, cfg))
+	// Kept for frontends that still post to the original ten-cities route.
+	route("/search/ten-cities", search, handlers.MultiCitySearchHandler(runner, cityGroups, cfg))
+	route("/groups", middleware.Methods{
+		http.MethodGet:  access.ReadData,
+		http.MethodPost: access.ManageGroups,
+	}, handlers.GroupsHandler(cityGroups))
+	route("/groups/{name}", middleware.Methods{
+		http.MethodGet:    access.ReadData,
+		http.MethodPut:    access.ManageGroups,
+		http.MethodDelete: access.ManageGroups,
+	}, handlers.GroupHandler(cityGroups))
+	route("/analytics", read, handlers.AnalyticsHandler(st))
+	route("/changes", read, handlers.ChangesHandler(st))
+	route("/usage", read, handlers.UsageHandler(ledger))
+	route("/usage/budget", middleware.Methods{
+		http.MethodGet: access.ReadData,
+		http.MethodPut: access.ManageBudgets,
+	}, handlers.BudgetHandler(ledger))
+	route("/snapshots/{id}", read, handlers.SnapshotHandler(st))
+	route("/brands", middleware.Methods{
+		http.MethodGet:  access.ReadData,
+		http.MethodPost: access.ManageBrands,
+	}, handlers.BrandsHandler(brands))
+	route("/brands/{name}", middleware.Methods{
+		http.MethodGet:    access.ReadData,
+		http.MethodPut:    access.ManageBrands,
+		http.MethodDelete: access.ManageBrands,
+	}, handlers.BrandHandler(brands))
+	route("/jobs/{id}", middleware.Methods{
+		http.MethodGet:    access.ReadData,
+		http.MethodDelete: access.CancelJobs,
+	}, handlers.JobHandler(runner))
+	route("/schedules", middleware.Methods{
+		http.MethodGet:  access.ReadData,
+		http.MethodPost: access.ManageSchedules,
+	}, handlers.SchedulesHandler(schedules, runner, cfg))
+	route("/schedules/{id}", middleware.Methods{
+		http.MethodGet:    access.ReadData,
+		http.MethodPut:    access.ManageSchedules,
+		http.MethodDelete: access.ManageSchedules,
+	}, handlers.ScheduleHandler(schedules, runner, cfg))
+	route("/audit", middleware.Methods{http.MethodGet: access.ReadAudit}, handlers.AuditHandler(auditLog))
+
+	auditHandler := middleware.Audit(auditLog, mux)
+	authHandler := middleware.Auth(tenantStore, []byte(cfg.JWTSecret), auditHandler)
+	// Requests are throttled per address before Auth looks at their
+	// credentials, so guessing API keys or tokens is slowed down too.
+	addressHandler := middleware.AddressRateLimit(buckets, middleware.Limit(cfg.AddressRateLimit), authHandler)
+	if len(cfg.CORSAllowedOrigins) == 0 {
+		fmt.Println("CORS_ALLOWED_ORIGINS is empty: cross-origin requests will be rejected")
+	}
+	corsHandler := middleware.CORS(middleware.CORSConfig{
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		AllowedMethods:   cfg.CORSAllowedMethods,
+		AllowedHeaders:   cfg.CORSAllowedHeaders,
+		ExposedHeaders:   cfg.CORSExposedHeaders,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           cfg.CORSMaxAge,
+	}, addressHandler)
+
+	server := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.Port),
+		Handler:      corsHandler,
+		ReadTimeout:  cfg.HTTPReadTimeout,
+		WriteTimeout: cfg.HTTPWriteTimeout,
+		IdleTimeout:  cfg.HTTPIdleTimeout,
+	}
+	log.Fatal(server.ListenAndServe())
+}
+
+// newSerpProvider picks the SERP backend configured through SERP_PROVIDER.
+func newSerpProvider(cfg *config.Config) (providers.SerpProvider, error) {
+	switch cfg.SerpProvider {
+	case "", "serpapi":
+		return providers.NewSerpAPIProvider(cfg.SerpAPIKey), nil
+	case "fixture":
+		return providers.NewFixtureProvider(cfg.SerpFixtureDir), nil
+	default:
+		return nil, fmt.Errorf("unknown SERP_PROVIDER %q", cfg.SerpProvider)
+	}
+}
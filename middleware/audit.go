@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"google-monitoring/access"
+	"google-monitoring/audit"
+	"google-monitoring/tenants"
+)
+
+// maxAuditedBody caps the part of a request body kept in the audit log.
+const maxAuditedBody = 16 << 10
+
+// Audit records every request that may change something, that is all but
+// GET, HEAD and OPTIONS, in log once it has been answered. It must run
+// inside Auth to know who made the request.
+func Audit(log *audit.Log, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxAuditedBody+1))
+		if err != nil {
+			http.Error(w, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		identity := access.FromContext(r.Context())
+		entry := &audit.Entry{
+			Tenant:   tenants.NameFromContext(r.Context()),
+			Actor:    identity.Actor,
+			Role:     identity.Role,
+			Method:   r.Method,
+			Path:     r.URL.Path,
+			Query:    r.URL.RawQuery,
+			Status:   recorder.status,
+			Request:  auditedBody(body),
+			ClientIP: clientIP(r),
+		}
+		if err := log.Record(context.WithoutCancel(r.Context()), entry); err != nil {
+			fmt.Printf("Failed to audit %s %s: %v\n", r.Method, r.URL.Path, err)
+		}
+	})
+}
+
+func auditedBody(body []byte) string {
+	if len(body) > maxAuditedBody {
+		return string(body[:maxAuditedBody]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// statusRecorder remembers the status code a handler answered with.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google-monitoring/access"
+	"google-monitoring/tenants"
+)
+
+var errInvalidToken = errors.New("invalid bearer token")
+
+// Auth rejects requests that do not authenticate as a tenant and stores the
+// tenant and the caller's identity in the request context (see
+// tenants.FromContext and access.FromContext).
+//
+// Clients send an API key in the X-API-Key header or as an
+// "Authorization: Bearer" token. When jwtSecret is set, bearer tokens that
+// look like JWTs are verified as HS256 tokens instead; their "tenant" claim,
+// or "sub" when it is missing, names the tenant and their "role" claim the
+// role. Preflight requests pass through so CORS keeps working.
+func Auth(store *tenants.Store, jwtSecret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		tenant, identity, err := authenticate(r, store, jwtSecret)
+		switch {
+		case err == nil:
+			ctx := tenants.WithTenant(r.Context(), tenant)
+			next.ServeHTTP(w, r.WithContext(access.WithIdentity(ctx, identity)))
+		case errors.Is(err, tenants.ErrDisabled):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, tenants.ErrInvalidKey), errors.Is(err, tenants.ErrNotFound), errors.Is(err, errInvalidToken):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="google-monitoring"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		default:
+			fmt.Printf("Failed to authenticate request: %v\n", err)
+			http.Error(w, "Failed to authenticate request", http.StatusInternalServerError)
+		}
+	})
+}
+
+func authenticate(r *http.Request, store *tenants.Store, jwtSecret []byte) (*tenants.Tenant, access.Identity, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return nil, access.Identity{}, tenants.ErrInvalidKey
+		}
+		if len(jwtSecret) > 0 && strings.Count(token, ".") == 2 {
+			return authenticateJWT(r, store, token, jwtSecret)
+		}
+		key = token
+	}
+
+	tenant, apiKey, err := store.Authenticate(r.Context(), key)
+	if err != nil {
+		return nil, access.Identity{}, err
+	}
+	role, err := access.ParseRole(string(apiKey.Role))
+	if err != nil {
+		return nil, access.Identity{}, tenants.ErrInvalidKey
+	}
+	return tenant, access.Identity{Actor: "key:" + apiKey.ID.Hex(), Role: role}, nil
+}
+
+func authenticateJWT(r *http.Request, store *tenants.Store, token string, secret []byte) (*tenants.Tenant, access.Identity, error) {
+	claims, err := verifyJWT(token, secret, time.Now())
+	if err != nil {
+		return nil, access.Identity{}, err
+	}
+	role, err := access.ParseRole(claims.Role)
+	if err != nil {
+		return nil, access.Identity{}, errInvalidToken
+	}
+
+	tenant, err := store.Get(r.Context(), claims.tenant())
+	if err != nil {
+		return nil, access.Identity{}, err
+	}
+	if tenant.Disabled {
+		return nil, access.Identity{}, tenants.ErrDisabled
+	}
+
+	actor := "jwt"
+	if claims.Subject != "" {
+		actor += ":" + claims.Subject
+	}
+	return tenant, access.Identity{Actor: actor, Role: role}, nil
+}
+
+// jwtClaims holds the claims read from a bearer token.
+type jwtClaims struct {
+	Tenant    string `json:"tenant"`
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+// tenant is the tenant the token was issued for.
+func (c *jwtClaims) tenant() string {
+	if c.Tenant != "" {
+		return c.Tenant
+	}
+	return c.Subject
+}
+
+// verifyJWT checks the HS256 signature and validity window of token and
+// returns its claims. Tokens must expire and name a tenant.
+func verifyJWT(token string, secret []byte, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, errInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidToken
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, errInvalidToken
+	}
+	if claims.ExpiresAt == nil || now.Unix() >= *claims.ExpiresAt {
+		return nil, errInvalidToken
+	}
+	if claims.NotBefore != nil && now.Unix() < *claims.NotBefore {
+		return nil, errInvalidToken
+	}
+
+	if claims.tenant() == "" {
+		return nil, errInvalidToken
+	}
+	return &claims, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
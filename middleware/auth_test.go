@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/tenants"
+)
+
+var testSecret = []byte("test-secret")
+
+// signJWT builds a token with header and claims, signed with secret using
+// HS256 whatever the header says.
+func signJWT(t *testing.T, header, claims map[string]interface{}, secret []byte) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	unsigned := encode(header) + "." + encode(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func hs256() map[string]interface{} {
+	return map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+}
+
+func TestVerifyJWT(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{"tenant": "acme", "sub": "ana", "role": "admin", "exp": now.Add(time.Hour).Unix()}
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	unsigned := func(header map[string]interface{}) string {
+		token := signJWT(t, header, valid(), testSecret)
+		return token[:strings.LastIndex(token, ".")+1]
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		tenant string
+	}{
+		{"valid", signJWT(t, hs256(), valid(), testSecret), "acme"},
+		{"tenant from sub", signJWT(t, hs256(), with("tenant", nil), testSecret), "ana"},
+		{"nbf reached", signJWT(t, hs256(), with("nbf", now.Unix()), testSecret), "acme"},
+		{"other secret", signJWT(t, hs256(), valid(), []byte("other-secret")), ""},
+		{"tampered claims", strings.Replace(signJWT(t, hs256(), valid(), testSecret), ".", ".x", 1), ""},
+		{"garbled signature", signJWT(t, hs256(), valid(), testSecret) + "!", ""},
+		{"alg none", unsigned(map[string]interface{}{"alg": "none"}), ""},
+		{"alg none signed", signJWT(t, map[string]interface{}{"alg": "none"}, valid(), testSecret), ""},
+		{"alg HS512", signJWT(t, map[string]interface{}{"alg": "HS512"}, valid(), testSecret), ""},
+		{"alg RS256", signJWT(t, map[string]interface{}{"alg": "RS256"}, valid(), testSecret), ""},
+		{"alg lowercase", signJWT(t, map[string]interface{}{"alg": "hs256"}, valid(), testSecret), ""},
+		{"no alg", signJWT(t, map[string]interface{}{"typ": "JWT"}, valid(), testSecret), ""},
+		{"no exp", signJWT(t, hs256(), with("exp", nil), testSecret), ""},
+		{"expired", signJWT(t, hs256(), with("exp", now.Add(-time.Second).Unix()), testSecret), ""},
+		{"expiring now", signJWT(t, hs256(), with("exp", now.Unix()), testSecret), ""},
+		{"future nbf", signJWT(t, hs256(), with("nbf", now.Add(time.Minute).Unix()), testSecret), ""},
+		{"no tenant", signJWT(t, hs256(), map[string]interface{}{"role": "admin", "exp": now.Add(time.Hour).Unix()}, testSecret), ""},
+		{"empty tenant", signJWT(t, hs256(), map[string]interface{}{"tenant": "", "sub": "", "exp": now.Add(time.Hour).Unix()}, testSecret), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifyJWT(tt.token, testSecret, now)
+			if tt.tenant == "" {
+				if !errors.Is(err, errInvalidToken) {
+					t.Errorf("verifyJWT = %+v, %v; want errInvalidToken", claims, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verifyJWT: %v", err)
+			}
+			if claims.tenant() != tt.tenant {
+				t.Errorf("tenant = %q, want %q", claims.tenant(), tt.tenant)
+			}
+		})
+	}
+}
+
+// newOfflineTenants returns a store whose every lookup fails, so that tests
+// can tell which requests reached it.
+func newOfflineTenants(t *testing.T) *tenants.Store {
+	t.Helper()
+
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1").
+		SetServerSelectionTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("mongo.Connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+	return tenants.NewStore(client, "tenants_test")
+}
+
+func TestAuthenticateChoosesCredential(t *testing.T) {
+	store := newOfflineTenants(t)
+	exp := time.Now().Add(time.Hour).Unix()
+	token := signJWT(t, hs256(), map[string]interface{}{"tenant": "acme", "exp": exp}, testSecret)
+	forged := signJWT(t, hs256(), map[string]interface{}{"tenant": "acme", "exp": exp}, []byte("other-secret"))
+	badRole := signJWT(t, hs256(), map[string]interface{}{"tenant": "acme", "role": "owner", "exp": exp}, testSecret)
+
+	tests := []struct {
+		name          string
+		apiKey        string
+		authorization string
+		secret        []byte
+		// want is the error expected, or "key lookup" and "tenant lookup"
+		// for requests that reached the store by API key or by tenant name.
+		want string
+	}{
+		{"no credentials", "", "", testSecret, "invalid API key"},
+		{"basic auth", "", "Basic YWNtZTpzZWNyZXQ=", testSecret, "invalid API key"},
+		{"empty bearer", "", "Bearer ", testSecret, "invalid API key"},
+		{"API key header", "key", "", testSecret, "key lookup"},
+		{"API key header wins over JWT", "key", "Bearer " + token, testSecret, "key lookup"},
+		{"API key header wins over forged JWT", "key", "Bearer " + forged, testSecret, "key lookup"},
+		{"bearer API key", "", "Bearer key", testSecret, "key lookup"},
+		{"lowercase scheme", "", "bearer key", testSecret, "key lookup"},
+		{"JWT", "", "Bearer " + token, testSecret, "tenant lookup"},
+		{"forged JWT", "", "Bearer " + forged, testSecret, "invalid bearer token"},
+		{"JWT with unknown role", "", "Bearer " + badRole, testSecret, "invalid bearer token"},
+		{"JWT without a secret", "", "Bearer " + token, nil, "key lookup"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/search", nil)
+			if tt.apiKey != "" {
+				r.Header.Set("X-API-Key", tt.apiKey)
+			}
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			_, _, err := authenticate(r, store, tt.secret)
+			var got string
+			switch {
+			case err == nil:
+				t.Fatal("authenticate succeeded without a database")
+			case strings.HasPrefix(err.Error(), "failed to look up API key"):
+				got = "key lookup"
+			case strings.HasPrefix(err.Error(), "failed to find tenant"):
+				got = "tenant lookup"
+			default:
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("authenticate = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthResponses(t *testing.T) {
+	handler := Auth(newOfflineTenants(t), testSecret, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	forged := signJWT(t, hs256(), map[string]interface{}{"tenant": "acme", "exp": time.Now().Add(time.Hour).Unix()}, []byte("other-secret"))
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		want          int
+	}{
+		{"preflight", http.MethodOptions, "", http.StatusNoContent},
+		{"no credentials", http.MethodGet, "", http.StatusUnauthorized},
+		{"forged JWT", http.MethodGet, "Bearer " + forged, http.StatusUnauthorized},
+		{"store unavailable", http.MethodGet, "Bearer key", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/search", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 without a WWW-Authenticate challenge")
+			}
+		})
+	}
+}
@@ -1,20 +1,128 @@
 package middleware
 
-import "net/http"
-
-// CORS middleware to enable cross-origin resource sharing
-func CORS(next http.Handler) http.Handler {
-    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-        w.Header().Set("Access-Control-Allow-Origin", "*")
-        w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-        w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-        
-        // If it's an OPTIONS request, just return a 200 status
-        if r.Method == http.MethodOptions {
-            w.WriteHeader(http.StatusOK)
-            return
-        }
-        
-        next.ServeHTTP(w, r)
-    })
-}
\ No newline at end of file
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSConfig is the cross-origin policy of the API.
+type CORSConfig struct {
+	// AllowedOrigins lists the origins browsers may call the API from, such
+	// as "https://app.example.com". "*" allows any origin and
+	// "https://*.example.com" any subdomain of example.com.
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	// ExposedHeaders lists the response headers scripts may read.
+	ExposedHeaders   []string
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight answer.
+	MaxAge time.Duration
+}
+
+// allowsOrigin reports whether origin is in the allow-list.
+func (c CORSConfig) allowsOrigin(origin string) bool {
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		scheme, host, ok := strings.Cut(allowed, "://*.")
+		if ok && strings.HasPrefix(strings.ToLower(origin), strings.ToLower(scheme)+"://") &&
+			strings.HasSuffix(strings.ToLower(origin), "."+strings.ToLower(host)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CORSConfig) allowsMethod(method string) bool {
+	return slices.ContainsFunc(c.AllowedMethods, func(m string) bool { return strings.EqualFold(m, method) })
+}
+
+// allowsHeaders reports whether every header of the comma-separated
+// Access-Control-Request-Headers value requested is allowed.
+func (c CORSConfig) allowsHeaders(requested string) bool {
+	for _, header := range strings.Split(requested, ",") {
+		header = strings.TrimSpace(header)
+		if header == "" {
+			continue
+		}
+		if !slices.ContainsFunc(c.AllowedHeaders, func(h string) bool { return strings.EqualFold(h, header) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// CORS applies the cross-origin policy of cfg. Requests from origins that
+// are not allowed, and preflights asking for methods or headers that are
+// not, are answered 403. Preflights are answered here; everything else,
+// including requests without an Origin, goes on to next.
+func CORS(cfg CORSConfig, next http.Handler) http.Handler {
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	exposed := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*") && !cfg.AllowCredentials
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		// The answer depends on the origin unless every origin gets "*".
+		if !anyOrigin {
+			w.Header().Add("Vary", "Origin")
+		}
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !cfg.allowsOrigin(origin) {
+			http.Error(w, "Origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		if anyOrigin {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		if cfg.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			if exposed != "" {
+				w.Header().Set("Access-Control-Expose-Headers", exposed)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !cfg.allowsMethod(r.Header.Get("Access-Control-Request-Method")) {
+			http.Error(w, "Method not allowed by CORS policy", http.StatusForbidden)
+			return
+		}
+		if !cfg.allowsHeaders(r.Header.Get("Access-Control-Request-Headers")) {
+			http.Error(w, "Headers not allowed by CORS policy", http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Methods", methods)
+		if headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		}
+		if cfg.MaxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", maxAge)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,268 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+var testCORS = CORSConfig{
+	AllowedOrigins: []string{"https://app.example.com", "https://*.partner.com"},
+	AllowedMethods: []string{"GET", "POST", "DELETE"},
+	AllowedHeaders: []string{"Authorization", "Content-Type", "X-API-Key"},
+	ExposedHeaders: []string{"X-Run-ID", "Retry-After"},
+	MaxAge:         10 * time.Minute,
+}
+
+func TestCORS(t *testing.T) {
+	withCredentials := testCORS
+	withCredentials.AllowCredentials = true
+
+	anyOrigin := testCORS
+	anyOrigin.AllowedOrigins = []string{"*"}
+
+	anyOriginWithCredentials := anyOrigin
+	anyOriginWithCredentials.AllowCredentials = true
+
+	tests := []struct {
+		name    string
+		cfg     CORSConfig
+		method  string
+		headers map[string]string
+
+		status      int
+		reachesNext bool
+		// want lists response headers and their expected value; "" means
+		// the header must be absent.
+		want map[string]string
+		vary []string
+	}{
+		{
+			name:        "no origin",
+			cfg:         testCORS,
+			method:      "GET",
+			status:      http.StatusOK,
+			reachesNext: true,
+			want:        map[string]string{"Access-Control-Allow-Origin": ""},
+			vary:        []string{"Origin"},
+		},
+		{
+			name:        "allowed origin",
+			cfg:         testCORS,
+			method:      "GET",
+			headers:     map[string]string{"Origin": "https://app.example.com"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example.com",
+				"Access-Control-Expose-Headers":    "X-Run-ID, Retry-After",
+				"Access-Control-Allow-Credentials": "",
+			},
+			vary: []string{"Origin"},
+		},
+		{
+			name:        "allowed origin in another case",
+			cfg:         testCORS,
+			method:      "GET",
+			headers:     map[string]string{"Origin": "https://APP.example.com"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want:        map[string]string{"Access-Control-Allow-Origin": "https://APP.example.com"},
+		},
+		{
+			name:        "wildcard subdomain",
+			cfg:         testCORS,
+			method:      "POST",
+			headers:     map[string]string{"Origin": "https://eu.dashboard.partner.com"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want:        map[string]string{"Access-Control-Allow-Origin": "https://eu.dashboard.partner.com"},
+		},
+		{
+			name:    "wildcard does not match the bare domain",
+			cfg:     testCORS,
+			method:  "GET",
+			headers: map[string]string{"Origin": "https://partner.com"},
+			status:  http.StatusForbidden,
+			want:    map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:    "wildcard does not match a look-alike domain",
+			cfg:     testCORS,
+			method:  "GET",
+			headers: map[string]string{"Origin": "https://evilpartner.com"},
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "wildcard does not match another scheme",
+			cfg:     testCORS,
+			method:  "GET",
+			headers: map[string]string{"Origin": "http://app.partner.com"},
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "disallowed origin",
+			cfg:     testCORS,
+			method:  "GET",
+			headers: map[string]string{"Origin": "https://evil.example.org"},
+			status:  http.StatusForbidden,
+			want:    map[string]string{"Access-Control-Allow-Origin": ""},
+			vary:    []string{"Origin"},
+		},
+		{
+			name:        "credentials",
+			cfg:         withCredentials,
+			method:      "GET",
+			headers:     map[string]string{"Origin": "https://app.example.com"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example.com",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		{
+			name:        "any origin",
+			cfg:         anyOrigin,
+			method:      "GET",
+			headers:     map[string]string{"Origin": "https://anything.example.net"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want:        map[string]string{"Access-Control-Allow-Origin": "*", "Vary": ""},
+		},
+		{
+			name:        "any origin with credentials echoes the origin",
+			cfg:         anyOriginWithCredentials,
+			method:      "GET",
+			headers:     map[string]string{"Origin": "https://anything.example.net"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want: map[string]string{
+				"Access-Control-Allow-Origin":      "https://anything.example.net",
+				"Access-Control-Allow-Credentials": "true",
+			},
+			vary: []string{"Origin"},
+		},
+		{
+			name:   "preflight",
+			cfg:    testCORS,
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                         "https://app.example.com",
+				"Access-Control-Request-Method":  "DELETE",
+				"Access-Control-Request-Headers": "authorization, content-type",
+			},
+			status: http.StatusNoContent,
+			want: map[string]string{
+				"Access-Control-Allow-Origin":   "https://app.example.com",
+				"Access-Control-Allow-Methods":  "GET, POST, DELETE",
+				"Access-Control-Allow-Headers":  "Authorization, Content-Type, X-API-Key",
+				"Access-Control-Max-Age":        "600",
+				"Access-Control-Expose-Headers": "",
+			},
+			vary: []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+		},
+		{
+			name:   "preflight without max age",
+			cfg:    CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET"}},
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                        "https://app.example.com",
+				"Access-Control-Request-Method": "GET",
+			},
+			status: http.StatusNoContent,
+			want:   map[string]string{"Access-Control-Max-Age": "", "Access-Control-Allow-Headers": ""},
+		},
+		{
+			name:   "preflight with credentials",
+			cfg:    withCredentials,
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                        "https://app.example.com",
+				"Access-Control-Request-Method": "POST",
+			},
+			status: http.StatusNoContent,
+			want:   map[string]string{"Access-Control-Allow-Credentials": "true"},
+		},
+		{
+			name:   "preflight for a disallowed method",
+			cfg:    testCORS,
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                        "https://app.example.com",
+				"Access-Control-Request-Method": "PUT",
+			},
+			status: http.StatusForbidden,
+			want:   map[string]string{"Access-Control-Allow-Methods": ""},
+			vary:   []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+		},
+		{
+			name:   "preflight for a disallowed header",
+			cfg:    testCORS,
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                         "https://app.example.com",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "Content-Type, X-Debug",
+			},
+			status: http.StatusForbidden,
+			want:   map[string]string{"Access-Control-Allow-Headers": ""},
+		},
+		{
+			name:   "preflight from a disallowed origin",
+			cfg:    testCORS,
+			method: "OPTIONS",
+			headers: map[string]string{
+				"Origin":                        "https://evil.example.org",
+				"Access-Control-Request-Method": "GET",
+			},
+			status: http.StatusForbidden,
+			want:   map[string]string{"Access-Control-Allow-Origin": "", "Access-Control-Allow-Methods": ""},
+		},
+		{
+			name:        "OPTIONS without a request method is not a preflight",
+			cfg:         testCORS,
+			method:      "OPTIONS",
+			headers:     map[string]string{"Origin": "https://app.example.com"},
+			status:      http.StatusOK,
+			reachesNext: true,
+			want:        map[string]string{"Access-Control-Allow-Methods": ""},
+			vary:        []string{"Origin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reached := false
+			handler := CORS(tt.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reached = true
+			}))
+
+			req := httptest.NewRequest(tt.method, "/search", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if reached != tt.reachesNext {
+				t.Errorf("reached next = %v, want %v", reached, tt.reachesNext)
+			}
+			for key, want := range tt.want {
+				if got := rec.Header().Get(key); got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+			if tt.vary != nil {
+				if got := rec.Header().Values("Vary"); !slices.Equal(got, tt.vary) {
+					t.Errorf("Vary = %q, want %q", got, tt.vary)
+				}
+			}
+		})
+	}
+}
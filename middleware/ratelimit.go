@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google-monitoring/access"
+	"google-monitoring/tenants"
+)
+
+// Limit is a token bucket: a client may send Requests requests at once, and
+// one more every Per/Requests after that. A zero Limit does not limit.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Rate is the number of tokens the bucket regains per second.
+func (l Limit) Rate() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+func (l Limit) disabled() bool {
+	return l.Requests <= 0 || l.Per <= 0
+}
+
+// BucketStore keeps the token buckets of the rate limiter.
+type BucketStore interface {
+	// Take refills the bucket called key for the time elapsed since it was
+	// last used, then takes a token from it when there is one. It returns
+	// the tokens left and whether one was taken.
+	Take(ctx context.Context, key string, limit Limit) (tokens float64, allowed bool, err error)
+}
+
+// RateLimits maps HTTP methods to the limit of their requests; the "" entry
+// applies to every other method.
+type RateLimits map[string]Limit
+
+func (l RateLimits) forMethod(method string) Limit {
+	if limit, ok := l[method]; ok {
+		return limit
+	}
+	return l[""]
+}
+
+// RateLimit limits the requests each client sends to route with a token
+// bucket per client, route and limit. Clients are told by API key or token
+// once Auth has run, by address otherwise. Answers carry the RateLimit-*
+// headers, and refused requests get a 429 with Retry-After. When buckets
+// fails the request is let through.
+func RateLimit(buckets BucketStore, route string, limits RateLimits, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := limits.forMethod(r.Method)
+		key := fmt.Sprintf("%s|%d/%s|%s", route, limit.Requests, limit.Per, rateLimitClient(r))
+		limitRequest(buckets, key, limit, next, w, r)
+	})
+}
+
+// AddressRateLimit limits the requests sent from each client address,
+// whatever their credentials. It goes before Auth, so that floods of
+// requests with invalid or made-up credentials are throttled as well; its
+// limit should leave room for several clients behind one address.
+func AddressRateLimit(buckets BucketStore, limit Limit, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := fmt.Sprintf("address|%d/%s|ip:%s", limit.Requests, limit.Per, clientIP(r))
+		limitRequest(buckets, key, limit, next, w, r)
+	})
+}
+
+// limitRequest takes a token from the bucket called key and passes r on to
+// next, or answers 429 when the bucket is empty.
+func limitRequest(buckets BucketStore, key string, limit Limit, next http.Handler, w http.ResponseWriter, r *http.Request) {
+	if limit.disabled() || r.Method == http.MethodOptions {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	tokens, allowed, err := buckets.Take(r.Context(), key, limit)
+	if err != nil {
+		fmt.Printf("Rate limiter unavailable, letting %s %s through: %v\n", r.Method, r.URL.Path, err)
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	rate := limit.Rate()
+	header := w.Header()
+	header.Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+	header.Set("RateLimit-Remaining", strconv.Itoa(int(math.Floor(tokens))))
+	header.Set("RateLimit-Reset", strconv.Itoa(seconds((float64(limit.Requests)-tokens)/rate)))
+	header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Requests, seconds(limit.Per.Seconds())))
+
+	if !allowed {
+		header.Set("Retry-After", strconv.Itoa(max(seconds((1-tokens)/rate), 1)))
+		http.Error(w, "Too many requests", http.StatusTooManyRequests)
+		return
+	}
+	next.ServeHTTP(w, r)
+}
+
+// rateLimitClient names the client a request counts against. Credentials
+// are hashed so they never reach the bucket store.
+func rateLimitClient(r *http.Request) string {
+	if actor := access.FromContext(r.Context()).Actor; actor != "" {
+		return tenants.NameFromContext(r.Context()) + "|" + actor
+	}
+	credential := r.Header.Get("X-API-Key")
+	if credential == "" {
+		credential = r.Header.Get("Authorization")
+	}
+	if credential != "" {
+		sum := sha256.Sum256([]byte(credential))
+		return "credential:" + hex.EncodeToString(sum[:8])
+	}
+	return "ip:" + clientIP(r)
+}
+
+func seconds(s float64) int {
+	return int(math.Ceil(s))
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// countingBuckets is a BucketStore that never refills.
+type countingBuckets struct {
+	taken map[string]int
+	err   error
+}
+
+func (b *countingBuckets) Take(_ context.Context, key string, limit Limit) (float64, bool, error) {
+	if b.err != nil {
+		return 0, false, b.err
+	}
+	if b.taken[key] >= limit.Requests {
+		return 0, false, nil
+	}
+	b.taken[key]++
+	return float64(limit.Requests - b.taken[key]), true, nil
+}
+
+func request(method, address, apiKey string) *http.Request {
+	r := httptest.NewRequest(method, "/search", nil)
+	r.RemoteAddr = address
+	if apiKey != "" {
+		r.Header.Set("X-API-Key", apiKey)
+	}
+	return r
+}
+
+func TestAddressRateLimitIgnoresCredentials(t *testing.T) {
+	buckets := &countingBuckets{taken: map[string]int{}}
+	reached := 0
+	handler := AddressRateLimit(buckets, Limit{Requests: 3, Per: time.Minute}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+
+	for i := range 5 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, request("POST", "203.0.113.7:5000", fmt.Sprintf("guess-%d", i)))
+
+		want := http.StatusUnauthorized
+		if i >= 3 {
+			want = http.StatusTooManyRequests
+		}
+		if rec.Code != want {
+			t.Errorf("request %d: status = %d, want %d", i, rec.Code, want)
+		}
+		if rec.Header().Get("RateLimit-Limit") != "3" {
+			t.Errorf("request %d: RateLimit-Limit = %q", i, rec.Header().Get("RateLimit-Limit"))
+		}
+		if want == http.StatusTooManyRequests && rec.Header().Get("Retry-After") == "" {
+			t.Errorf("request %d: no Retry-After", i)
+		}
+	}
+	if reached != 3 {
+		t.Errorf("next reached %d times, want 3", reached)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, request("POST", "198.51.100.4:5000", "guess-0"))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("another address: status = %d, want 401", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, request("OPTIONS", "203.0.113.7:5000", ""))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("OPTIONS: status = %d, want it let through", rec.Code)
+	}
+}
+
+func TestRateLimitKeysByCredential(t *testing.T) {
+	buckets := &countingBuckets{taken: map[string]int{}}
+	handler := RateLimit(buckets, "/search", RateLimits{"": {Requests: 1, Per: time.Minute}}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for _, tt := range []struct {
+		apiKey string
+		want   int
+	}{
+		{"key-a", http.StatusOK},
+		{"key-a", http.StatusTooManyRequests},
+		{"key-b", http.StatusOK},
+		{"", http.StatusOK},
+		{"", http.StatusTooManyRequests},
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, request("GET", "203.0.113.7:5000", tt.apiKey))
+		if rec.Code != tt.want {
+			t.Errorf("key %q: status = %d, want %d", tt.apiKey, rec.Code, tt.want)
+		}
+	}
+}
+
+func TestRateLimitFailsOpen(t *testing.T) {
+	buckets := &countingBuckets{err: errors.New("connection refused")}
+	handler := AddressRateLimit(buckets, Limit{Requests: 1, Per: time.Minute}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for range 3 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, request("GET", "203.0.113.7:5000", ""))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want the request let through", rec.Code)
+		}
+	}
+}
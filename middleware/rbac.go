@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	"google-monitoring/access"
+)
+
+// Methods maps the HTTP methods a route serves to the permission each one
+// requires.
+type Methods map[string]access.Permission
+
+// Require lets a request through to next only when the caller's role has the
+// permission its method requires. Methods missing from methods are answered
+// 405 so new ones are never served unchecked.
+func Require(methods Methods, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		permission, ok := methods[r.Method]
+		if !ok {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !access.Can(access.FromContext(r.Context()).Role, permission) {
+			http.Error(w, "Forbidden: "+string(permission)+" permission required", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,416 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/brand"
+	"google-monitoring/changes"
+	"google-monitoring/domains"
+	"google-monitoring/enrich"
+	"google-monitoring/notify"
+	"google-monitoring/providers"
+	"google-monitoring/report"
+	"google-monitoring/store"
+)
+
+// ErrNoResults is recorded for a city whose SERP had neither ads nor organic results.
+var ErrNoResults = errors.New("'ads' or 'organic_results' field not found in search results")
+
+// Monitor runs the monitoring pipeline: SERP lookup per city, enrichment,
+// persistence of the run and its observations, and the report.
+type Monitor struct {
+	store     *store.Store
+	provider  providers.SerpProvider
+	brands    *brand.Store
+	enrichers *enrich.Pipeline
+	notifier  *notify.Dispatcher
+	reports   *report.Renderer
+}
+
+func New(st *store.Store, provider providers.SerpProvider, brands *brand.Store, enrichers *enrich.Pipeline, notifier *notify.Dispatcher, reports *report.Renderer) *Monitor {
+	return &Monitor{store: st, provider: provider, brands: brands, enrichers: enrichers, notifier: notifier, reports: reports}
+}
+
+// Request describes one monitoring run.
+type Request struct {
+	Query     string
+	Cities    []string
+	Device    string
+	Requester string
+	// Brand names the brand profile the ads are classified against, if any.
+	Brand string
+	// Enrichers names the enrichers to run, in order. Nil runs every
+	// registered enricher and an empty list none.
+	Enrichers []string
+	// ReplayOf is set when the run re-processes the archived responses of
+	// an earlier run.
+	ReplayOf *primitive.ObjectID
+	// Notify lists where the report of the run is sent.
+	Notify []notify.Channel
+	// Tenant owns the run and selects the search budget applied to it.
+	Tenant string
+}
+
+// Outcome is what a finished run produced.
+type Outcome struct {
+	Run          *store.Run
+	Observations []*store.Observation
+	// Errors holds the failure of every city that did not succeed.
+	Errors map[string]error
+	// Changes lists how the ads differ from the previous run, set by Finish.
+	Changes []store.AdChange
+}
+
+// Results returns the results of all observations, in city order.
+func (o *Outcome) Results() []store.Result {
+	var results []store.Result
+	for _, observation := range o.Observations {
+		if observation != nil {
+			results = append(results, observation.Results...)
+		}
+	}
+	return results
+}
+
+// WithProvider returns a copy of m that looks results up with provider.
+func (m *Monitor) WithProvider(provider providers.SerpProvider) *Monitor {
+	copied := *m
+	copied.provider = provider
+	return &copied
+}
+
+// Profile loads the brand profile of tenant called name; it returns nil when
+// name is empty.
+func (m *Monitor) Profile(ctx context.Context, tenant, name string) (*brand.Profile, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return m.brands.Get(ctx, tenant, name)
+}
+
+// Start stores a new running run for req.
+func (m *Monitor) Start(ctx context.Context, req Request) (*store.Run, error) {
+	run := &store.Run{
+		Query:        req.Query,
+		Cities:       req.Cities,
+		Device:       req.Device,
+		Requester:    req.Requester,
+		Tenant:       req.Tenant,
+		BrandProfile: req.Brand,
+		Enrichers:    req.Enrichers,
+		ReplayOf:     req.ReplayOf,
+	}
+	if _, err := m.enrichers.Select(req.Enrichers); err != nil {
+		return nil, err
+	}
+	if err := m.store.StartRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// Observe looks city up for run, classifies its ads against profile (when
+// not nil) and stores the observation. The returned error is the reason the
+// city failed; the observation is stored either way.
+func (m *Monitor) Observe(ctx context.Context, run *store.Run, profile *brand.Profile, city string) (*store.Observation, error) {
+	observation := &store.Observation{City: city}
+	err := m.observeCity(ctx, run, observation)
+	if errors.Is(err, providers.ErrNotCached) {
+		return nil, err
+	}
+	if err == nil && profile != nil {
+		for i := range observation.Results {
+			observation.Results[i].Brand = profile.Classify(run.Query, observation.Results[i].Serp)
+		}
+	}
+	m.addObservation(ctx, run, observation)
+	return observation, err
+}
+
+// ObserveCached is Observe answered from the SERP cache alone, for tenants
+// without credits left. When the cache cannot answer it returns
+// providers.ErrNotCached and stores nothing.
+func (m *Monitor) ObserveCached(ctx context.Context, run *store.Run, profile *brand.Profile, city string) (*store.Observation, error) {
+	if _, ok := m.provider.(*providers.CachingProvider); !ok {
+		return nil, providers.ErrNotCached
+	}
+	return m.Observe(providers.CacheOnly(ctx), run, profile, city)
+}
+
+// Uncached returns how many cities of req are not in the SERP cache, and
+// would cost credits to look up.
+func (m *Monitor) Uncached(ctx context.Context, req Request) int {
+	caching, ok := m.provider.(*providers.CachingProvider)
+	if !ok {
+		return len(req.Cities)
+	}
+	uncached := 0
+	for _, city := range req.Cities {
+		if !caching.Cached(ctx, providers.SerpRequest{Query: req.Query, Location: city, Device: req.Device}) {
+			uncached++
+		}
+	}
+	return uncached
+}
+
+// Skip stores an observation for a city that was not looked up.
+func (m *Monitor) Skip(ctx context.Context, run *store.Run, city, reason string) *store.Observation {
+	observation := &store.Observation{
+		City:   city,
+		Status: store.ObservationSkipped,
+		Error:  reason,
+	}
+	m.addObservation(ctx, run, observation)
+	return observation
+}
+
+func (m *Monitor) addObservation(ctx context.Context, run *store.Run, observation *store.Observation) {
+	if err := m.store.AddObservation(context.WithoutCancel(ctx), run, observation); err != nil {
+		fmt.Printf("Failed to store observation for city %s: %v\n", observation.City, err)
+	}
+}
+
+// Finish stores the final status of the run and sends its report to the
+// channels. A run whose context was cancelled is marked as cancelled.
+func (m *Monitor) Finish(ctx context.Context, outcome *Outcome, channels []notify.Channel) {
+	statuses := make([]store.ObservationStatus, 0, len(outcome.Observations))
+	for _, observation := range outcome.Observations {
+		if observation != nil {
+			statuses = append(statuses, observation.Status)
+		}
+	}
+
+	outcome.Run.Infringing = Infringing(outcome.Observations)
+	// A replay sees the same pages as its original run, so comparing it
+	// with the previous run would only report extraction changes.
+	if outcome.Run.ReplayOf == nil {
+		outcome.Changes = m.detectChanges(context.WithoutCancel(ctx), outcome.Observations)
+	}
+
+	status := store.StatusFor(statuses)
+	if ctx.Err() != nil {
+		status = store.RunCancelled
+	}
+	if err := m.store.FinishRun(context.WithoutCancel(ctx), outcome.Run, status); err != nil {
+		fmt.Printf("Failed to finish run: %v\n", err)
+	}
+
+	if len(channels) > 0 && status != store.RunCancelled {
+		rendered, err := m.reports.Render(report.Build(outcome.Run, outcome.Observations, outcome.Changes))
+		if err != nil {
+			fmt.Printf("Failed to render report of run %s: %v\n", outcome.Run.ID.Hex(), err)
+			return
+		}
+
+		notification := notify.Notification{
+			Subject: rendered.Subject,
+			Text:    rendered.Text,
+			HTML:    rendered.HTML,
+			Attachments: []notify.Attachment{{
+				Filename:    rendered.CSVFilename,
+				ContentType: "text/csv; charset=utf-8",
+				Data:        rendered.CSV,
+			}},
+			Run:          outcome.Run,
+			Observations: outcome.Observations,
+			Changes:      outcome.Changes,
+		}
+		go func() {
+			if err := m.notifier.Send(context.Background(), channels, notification); err != nil {
+				fmt.Printf("Failed to send notifications: %v\n", err)
+			}
+		}()
+	}
+}
+
+// detectChanges compares every successful observation with the previous
+// one for its city and records the differences.
+func (m *Monitor) detectChanges(ctx context.Context, observations []*store.Observation) []store.AdChange {
+	var detected []store.AdChange
+	for _, observation := range observations {
+		if observation == nil || observation.Status != store.ObservationOK {
+			continue
+		}
+
+		previous, err := m.store.PreviousObservation(ctx, observation)
+		if err != nil {
+			fmt.Printf("Failed to detect ad changes: %v\n", err)
+			continue
+		}
+		detected = append(detected, changes.Diff(previous, observation)...)
+	}
+
+	if err := m.store.RecordChanges(ctx, detected); err != nil {
+		fmt.Printf("Failed to record ad changes: %v\n", err)
+	}
+	return detected
+}
+
+// Infringing collects the advertisers labelled infringing in observations.
+func Infringing(observations []*store.Observation) []brand.Advertiser {
+	var collector brand.Collector
+	for _, observation := range observations {
+		if observation == nil {
+			continue
+		}
+		for _, result := range observation.Results {
+			if result.Brand != nil && result.Brand.Label == brand.LabelInfringing {
+				collector.Add(observation.City, result.Serp.AdvertiserDomain)
+			}
+		}
+	}
+	return collector.Advertisers()
+}
+
+// Advertiser summarises the results of one advertiser across a run.
+type Advertiser struct {
+	Domain  string   `json:"domain"`
+	Hosts   []string `json:"hosts"`
+	Ads     int      `json:"ads"`
+	Organic int      `json:"organic"`
+	Cities  []string `json:"cities"`
+}
+
+type cityResult struct {
+	city   string
+	result store.Result
+}
+
+// Advertisers groups the results of observations by advertiser domain.
+func Advertisers(observations []*store.Observation) []Advertiser {
+	var all []cityResult
+	for _, observation := range observations {
+		if observation == nil {
+			continue
+		}
+		for _, result := range observation.Results {
+			all = append(all, cityResult{city: observation.City, result: result})
+		}
+	}
+
+	groups := domains.GroupBy(all, func(c cityResult) string {
+		if c.result.Serp.Link != "" {
+			return c.result.Serp.Link
+		}
+		return c.result.Serp.DisplayedURL
+	})
+
+	advertisers := make([]Advertiser, 0, len(groups))
+	for _, group := range groups {
+		advertiser := Advertiser{Domain: group.Domain, Hosts: group.Hosts}
+		seen := map[string]bool{}
+		for _, c := range group.Items {
+			if c.result.Kind == providers.KindAd {
+				advertiser.Ads++
+			} else {
+				advertiser.Organic++
+			}
+			if !seen[c.city] {
+				seen[c.city] = true
+				advertiser.Cities = append(advertiser.Cities, c.city)
+			}
+		}
+		advertisers = append(advertisers, advertiser)
+	}
+	return advertisers
+}
+
+// observeCity runs the SERP lookup and enrichment for observation.City and
+// fills in the observation's status and results.
+func (m *Monitor) observeCity(ctx context.Context, run *store.Run, observation *store.Observation) error {
+	err := func() error {
+		results, err := m.provider.Search(ctx, providers.SerpRequest{
+			Query:    run.Query,
+			Location: observation.City,
+			Device:   run.Device,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get search results: %w", err)
+		}
+		observation.Credits = results.Credits
+		switch {
+		case results.Cached:
+			observation.Cached = true
+			observation.AgeSeconds = int(time.Since(results.FetchedAt).Seconds())
+		case !results.FetchedAt.IsZero():
+			observation.ObservedAt = results.FetchedAt
+		}
+		m.archive(ctx, run, observation, results)
+
+		serps := serpResults(results)
+		if serps == nil {
+			return ErrNoResults
+		}
+
+		stages, err := m.enrichers.Select(run.Enrichers)
+		if err != nil {
+			return err
+		}
+
+		searchResults := make([]store.Result, 0, len(serps))
+		for _, serp := range serps {
+			searchResults = append(searchResults, store.NewResult(serp))
+		}
+		m.enrichers.Run(ctx, run.Query, stages, searchResults)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		for i := range searchResults {
+			searchResults[i].Normalize()
+		}
+		observation.Results = searchResults
+		return nil
+	}()
+
+	if err != nil {
+		fmt.Printf("City %s: %v\n", observation.City, err)
+		observation.Status = store.ObservationFailed
+		observation.Error = err.Error()
+		return err
+	}
+
+	observation.Status = store.ObservationOK
+	return nil
+}
+
+// archive keeps the raw response of a lookup so it can be replayed. A
+// failure is logged and does not fail the lookup.
+func (m *Monitor) archive(ctx context.Context, run *store.Run, observation *store.Observation, results *providers.SerpResults) {
+	if len(results.Raw) == 0 {
+		return
+	}
+
+	fetchedAt := results.FetchedAt
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now().UTC()
+	}
+	err := m.store.ArchiveResponse(context.WithoutCancel(ctx), store.RawResponse{
+		RunID:     run.ID,
+		City:      observation.City,
+		Query:     run.Query,
+		Device:    run.Device,
+		Data:      results.Raw,
+		FetchedAt: fetchedAt,
+	})
+	if err != nil {
+		fmt.Printf("Failed to archive response: %v\n", err)
+	}
+}
+
+// serpResults returns the ads of a lookup followed by its organic results.
+// Every result carries its Kind, so the two lists stay apart in the stored
+// observation. It returns nil when the lookup had neither.
+func serpResults(results *providers.SerpResults) []providers.SerpResult {
+	if len(results.Ads) == 0 && len(results.Organic) == 0 {
+		return nil
+	}
+	all := make([]providers.SerpResult, 0, len(results.Ads)+len(results.Organic))
+	all = append(all, results.Ads...)
+	return append(all, results.Organic...)
+}
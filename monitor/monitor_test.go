@@ -0,0 +1,151 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/brand"
+	"google-monitoring/enrich"
+	"google-monitoring/providers"
+	"google-monitoring/serpcache"
+	"google-monitoring/store"
+)
+
+const fixtureCity = "Sao Paulo,State of Sao Paulo,Brazil"
+
+// newOfflineMonitor returns a monitor reading the fixtures in testdata. Its
+// store points at an address nothing listens on, so archiving and storing
+// observations fail quickly and are only logged, as they are in production.
+func newOfflineMonitor(t *testing.T) *Monitor {
+	t.Helper()
+	return newOfflineMonitorWith(t, providers.NewFixtureProvider("testdata"))
+}
+
+func newOfflineMonitorWith(t *testing.T, provider providers.SerpProvider) *Monitor {
+	t.Helper()
+
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1").
+		SetServerSelectionTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("mongo.Connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+	return New(store.New(client, "monitoring_test"), provider, nil, enrich.NewPipeline(1), nil, nil)
+}
+
+func newRun() *store.Run {
+	return &store.Run{
+		ID:        primitive.NewObjectID(),
+		Query:     "tenis corrida",
+		Device:    "desktop",
+		Enrichers: []string{},
+	}
+}
+
+func TestObserveFixture(t *testing.T) {
+	m := newOfflineMonitor(t)
+	profile := &brand.Profile{
+		Name:         "Passada",
+		OwnedDomains: []string{"passada.com.br"},
+		Terms:        []string{"passada"},
+	}
+
+	observation, err := m.Observe(context.Background(), newRun(), profile, fixtureCity)
+	if err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if observation.Status != store.ObservationOK {
+		t.Fatalf("status = %q, want %q", observation.Status, store.ObservationOK)
+	}
+
+	want := []struct {
+		kind       providers.ResultKind
+		link       string
+		advertiser string
+		label      brand.Label
+	}{
+		{providers.KindAd, "https://www.passada.com.br/corrida", "passada.com.br", brand.LabelOwned},
+		{providers.KindAd, "https://ofertas.revendaesperta.com/passada", "revendaesperta.com", brand.LabelInfringing},
+		{providers.KindOrganic, "https://www.passada.com.br/", "passada.com.br", ""},
+		{providers.KindOrganic, "https://blog.corredores.org/melhores-tenis", "corredores.org", ""},
+	}
+	if len(observation.Results) != len(want) {
+		t.Fatalf("got %d results, want %d: %+v", len(observation.Results), len(want), observation.Results)
+	}
+	for i, w := range want {
+		got := observation.Results[i]
+		if got.Kind != w.kind || got.Link != w.link || got.Serp.AdvertiserDomain != w.advertiser {
+			t.Errorf("result %d = {%s %s %s}, want {%s %s %s}", i, got.Kind, got.Link, got.Serp.AdvertiserDomain, w.kind, w.link, w.advertiser)
+		}
+		switch {
+		case w.label == "" && got.Brand != nil:
+			t.Errorf("result %d verdict = %+v, want none", i, got.Brand)
+		case w.label != "" && (got.Brand == nil || got.Brand.Label != w.label):
+			t.Errorf("result %d verdict = %+v, want %s", i, got.Brand, w.label)
+		}
+	}
+
+	if sitelinks := observation.Results[0].Serp.Sitelinks; len(sitelinks) != 1 || sitelinks[0].Link != "https://www.passada.com.br/lancamentos" {
+		t.Errorf("sitelinks = %+v, want the lancamentos link without tracking", sitelinks)
+	}
+	if infringing := Infringing([]*store.Observation{observation}); len(infringing) != 1 || infringing[0].Domain != "revendaesperta.com" {
+		t.Errorf("Infringing = %+v, want revendaesperta.com", infringing)
+	}
+}
+
+func TestObserveNoResults(t *testing.T) {
+	m := newOfflineMonitor(t)
+
+	observation, err := m.Observe(context.Background(), newRun(), nil, "Campinas,State of Sao Paulo,Brazil")
+	if !errors.Is(err, ErrNoResults) {
+		t.Fatalf("Observe error = %v, want ErrNoResults", err)
+	}
+	if observation.Status != store.ObservationFailed || observation.Error != ErrNoResults.Error() {
+		t.Errorf("observation = {%s %q}, want a failed observation recording ErrNoResults", observation.Status, observation.Error)
+	}
+}
+
+func TestObserveCached(t *testing.T) {
+	if _, err := newOfflineMonitor(t).ObserveCached(context.Background(), newRun(), nil, fixtureCity); !errors.Is(err, providers.ErrNotCached) {
+		t.Errorf("ObserveCached without a cache: error = %v, want ErrNotCached", err)
+	}
+
+	caching := &providers.CachingProvider{
+		Provider: providers.NewFixtureProvider("testdata"),
+		Cache:    serpcache.NewMemory(10),
+		TTL:      time.Hour,
+	}
+	m := newOfflineMonitorWith(t, caching)
+	run := newRun()
+
+	observation, err := m.ObserveCached(context.Background(), run, nil, fixtureCity)
+	if !errors.Is(err, providers.ErrNotCached) || observation != nil {
+		t.Fatalf("ObserveCached on a cold cache = %v, %v; want nothing and ErrNotCached", observation, err)
+	}
+	if got := m.Uncached(context.Background(), Request{Query: run.Query, Device: run.Device, Cities: []string{fixtureCity}}); got != 1 {
+		t.Errorf("Uncached on a cold cache = %d, want 1", got)
+	}
+
+	if _, err := m.Observe(context.Background(), run, nil, fixtureCity); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if got := m.Uncached(context.Background(), Request{Query: run.Query, Device: run.Device, Cities: []string{fixtureCity, "Jau,State of Sao Paulo,Brazil"}}); got != 1 {
+		t.Errorf("Uncached after a lookup = %d, want 1", got)
+	}
+
+	observation, err = m.ObserveCached(context.Background(), run, nil, fixtureCity)
+	if err != nil {
+		t.Fatalf("ObserveCached on a warm cache: %v", err)
+	}
+	if !observation.Cached || observation.Credits != 0 || len(observation.Results) != 4 {
+		t.Errorf("ObserveCached = cached %v, %d credits, %d results; want 4 cached results for free", observation.Cached, observation.Credits, len(observation.Results))
+	}
+}
@@ -0,0 +1,159 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// BuildMessage renders n as an RFC 5322 message. The body is text/plain, or
+// multipart/alternative when n has HTML; attachments wrap it in
+// multipart/mixed.
+func BuildMessage(from string, to []string, n Notification) ([]byte, error) {
+	var msg bytes.Buffer
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from)
+	header.Set("To", strings.Join(to, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", n.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("MIME-Version", "1.0")
+
+	bodyHeader, body, err := buildBody(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(n.Attachments) > 0 {
+		var mixed bytes.Buffer
+		w := multipart.NewWriter(&mixed)
+
+		part, err := w.CreatePart(bodyHeader)
+		if err != nil {
+			return nil, err
+		}
+		part.Write(body)
+
+		for _, attachment := range n.Attachments {
+			if err := writeAttachment(w, attachment); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+
+		bodyHeader = textproto.MIMEHeader{}
+		bodyHeader.Set("Content-Type", "multipart/mixed; boundary="+w.Boundary())
+		body = mixed.Bytes()
+	}
+
+	for key, values := range bodyHeader {
+		header[key] = values
+	}
+	writeHeader(&msg, header)
+	msg.Write(body)
+	return msg.Bytes(), nil
+}
+
+// buildBody returns the headers and content of the message body proper.
+func buildBody(n Notification) (textproto.MIMEHeader, []byte, error) {
+	textHeader, text, err := quotedPrintablePart("text/plain; charset=utf-8", n.Text)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n.HTML == "" {
+		return textHeader, text, nil
+	}
+
+	htmlHeader, html, err := quotedPrintablePart("text/html; charset=utf-8", n.HTML)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alternative bytes.Buffer
+	w := multipart.NewWriter(&alternative)
+	for _, p := range []struct {
+		header  textproto.MIMEHeader
+		content []byte
+	}{{textHeader, text}, {htmlHeader, html}} {
+		part, err := w.CreatePart(p.header)
+		if err != nil {
+			return nil, nil, err
+		}
+		part.Write(p.content)
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", "multipart/alternative; boundary="+w.Boundary())
+	return header, alternative.Bytes(), nil
+}
+
+func quotedPrintablePart(contentType, content string) (textproto.MIMEHeader, []byte, error) {
+	var buf bytes.Buffer
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(content)); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+	return header, buf.Bytes(), nil
+}
+
+func writeAttachment(w *multipart.Writer, attachment Attachment) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", attachment.ContentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to add attachment %s: %w", attachment.Filename, err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		fmt.Fprintf(part, "%s\r\n", encoded[:76])
+		encoded = encoded[76:]
+	}
+	fmt.Fprintf(part, "%s\r\n", encoded)
+	return nil
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	order := []string{"From", "To", "Subject", "Date", "Mime-Version", "Content-Type", "Content-Transfer-Encoding"}
+	written := map[string]bool{}
+	for _, key := range order {
+		name := key
+		if key == "Mime-Version" {
+			name = "MIME-Version"
+		}
+		for _, value := range header[key] {
+			fmt.Fprintf(buf, "%s: %s\r\n", name, value)
+		}
+		written[key] = true
+	}
+	for key, values := range header {
+		if written[key] {
+			continue
+		}
+		for _, value := range values {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,174 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// readPart returns the content of p as sent.
+func readPart(t *testing.T, p *multipart.Part) string {
+	t.Helper()
+	data, err := io.ReadAll(p)
+	if err != nil {
+		t.Fatalf("failed to read part: %v", err)
+	}
+	return string(data)
+}
+
+func multipartReader(t *testing.T, contentType, want string, body io.Reader) *multipart.Reader {
+	t.Helper()
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != want {
+		t.Fatalf("Content-Type = %q, want %s", contentType, want)
+	}
+	return multipart.NewReader(body, params["boundary"])
+}
+
+func TestBuildMessageStructure(t *testing.T) {
+	csvData := []byte("city,kind\nJaú,ad\n" + strings.Repeat("São Paulo,organic\n", 20))
+	n := Notification{
+		Subject: "Relatório: anúncios em São Paulo",
+		Text:    "Olá, 2 anúncios de concorrência foram encontrados.",
+		HTML:    "<p>Olá, <b>2 anúncios</b> de concorrência foram encontrados.</p>",
+		Attachments: []Attachment{{
+			Filename:    "resultados-ação.csv",
+			ContentType: "text/csv; charset=utf-8",
+			Data:        csvData,
+		}},
+	}
+
+	raw, err := BuildMessage("monitor@example.com", []string{"analyst@example.com"}, n)
+	if err != nil {
+		t.Fatalf("BuildMessage: %v", err)
+	}
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 998 {
+			t.Fatalf("line longer than RFC 5322 allows: %d", len(line))
+		}
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	subject := msg.Header.Get("Subject")
+	if !strings.HasPrefix(subject, "=?utf-8?q?") {
+		t.Errorf("Subject = %q, want an RFC 2047 encoded word", subject)
+	}
+	decoded, err := new(mime.WordDecoder).DecodeHeader(subject)
+	if err != nil || decoded != n.Subject {
+		t.Errorf("decoded Subject = %q (%v), want %q", decoded, err, n.Subject)
+	}
+	if msg.Header.Get("MIME-Version") != "1.0" {
+		t.Errorf("MIME-Version = %q", msg.Header.Get("MIME-Version"))
+	}
+
+	mixed := multipartReader(t, msg.Header.Get("Content-Type"), "multipart/mixed", msg.Body)
+
+	// The first part of the mixed body is the alternative text and HTML.
+	body, err := mixed.NextPart()
+	if err != nil {
+		t.Fatalf("missing body part: %v", err)
+	}
+	alternative := multipartReader(t, body.Header.Get("Content-Type"), "multipart/alternative", body)
+	for _, want := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", n.Text},
+		{"text/html; charset=utf-8", n.HTML},
+	} {
+		part, err := alternative.NextRawPart()
+		if err != nil {
+			t.Fatalf("missing %s part: %v", want.contentType, err)
+		}
+		if got := part.Header.Get("Content-Type"); got != want.contentType {
+			t.Errorf("Content-Type = %q, want %q", got, want.contentType)
+		}
+		if got := part.Header.Get("Content-Transfer-Encoding"); got != "quoted-printable" {
+			t.Errorf("%s Content-Transfer-Encoding = %q, want quoted-printable", want.contentType, got)
+		}
+		encoded := readPart(t, part)
+		if strings.ContainsAny(encoded, "áçã") {
+			t.Errorf("%s part is not quoted-printable encoded: %q", want.contentType, encoded)
+		}
+		content, err := io.ReadAll(quotedPrintableReader(encoded))
+		if err != nil || string(content) != want.content {
+			t.Errorf("%s content = %q (%v), want %q", want.contentType, content, err, want.content)
+		}
+	}
+	if _, err := alternative.NextPart(); err != io.EOF {
+		t.Errorf("unexpected third alternative part: %v", err)
+	}
+
+	attachment, err := mixed.NextRawPart()
+	if err != nil {
+		t.Fatalf("missing attachment: %v", err)
+	}
+	if got := attachment.Header.Get("Content-Type"); got != "text/csv; charset=utf-8" {
+		t.Errorf("attachment Content-Type = %q", got)
+	}
+	if got := attachment.Header.Get("Content-Transfer-Encoding"); got != "base64" {
+		t.Errorf("attachment Content-Transfer-Encoding = %q, want base64", got)
+	}
+	disposition, params, err := mime.ParseMediaType(attachment.Header.Get("Content-Disposition"))
+	if err != nil || disposition != "attachment" || params["filename"] != "resultados-ação.csv" {
+		t.Errorf("Content-Disposition = %q, want an attachment named resultados-ação.csv", attachment.Header.Get("Content-Disposition"))
+	}
+	encoded := readPart(t, attachment)
+	for _, line := range strings.Split(strings.TrimSpace(encoded), "\r\n") {
+		if len(line) > 76 {
+			t.Errorf("base64 line of %d characters, want at most 76", len(line))
+		}
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(encoded, "\r\n", ""))
+	if err != nil || !bytes.Equal(data, csvData) {
+		t.Errorf("attachment = %q (%v), want the csv data", data, err)
+	}
+
+	if _, err := mixed.NextPart(); err != io.EOF {
+		t.Errorf("unexpected part after the attachment: %v", err)
+	}
+}
+
+func TestBuildMessageTextOnly(t *testing.T) {
+	raw, err := BuildMessage("monitor@example.com", []string{"analyst@example.com"}, Notification{Subject: "Plain", Text: "só texto"})
+	if err != nil {
+		t.Fatalf("BuildMessage: %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+	if got := msg.Header.Get("Content-Type"); got != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want text/plain", got)
+	}
+	if got := msg.Header.Get("Subject"); got != "Plain" {
+		t.Errorf("ASCII Subject = %q, want it unencoded", got)
+	}
+	body, _ := io.ReadAll(quotedPrintableReader(readAll(t, msg.Body)))
+	if string(body) != "só texto" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func quotedPrintableReader(s string) io.Reader {
+	return quotedprintable.NewReader(strings.NewReader(s))
+}
+
+func readAll(t *testing.T, r io.Reader) string {
+	t.Helper()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,154 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"google-monitoring/enrich"
+	"google-monitoring/store"
+)
+
+// Channel types a monitoring request can select.
+const (
+	ChannelEmail    = "email"
+	ChannelWebhook  = "webhook"
+	ChannelSlack    = "slack"
+	ChannelTelegram = "telegram"
+)
+
+// Notification is the report of a finished run. Text is always set; HTML
+// and Attachments are used by channels that support them (email).
+type Notification struct {
+	Subject      string
+	Text         string
+	HTML         string
+	Attachments  []Attachment
+	Run          *store.Run
+	Observations []*store.Observation
+	Changes      []store.AdChange
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Notifier delivers notifications to one destination.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Channel selects where a run's report goes. Target is an email address, a
+// webhook URL, a Slack incoming-webhook URL or a Telegram chat id.
+type Channel struct {
+	Type   string `json:"type" bson:"type"`
+	Target string `json:"target" bson:"target"`
+}
+
+// EmailChannels turns plain addresses into email channels.
+func EmailChannels(addresses ...string) []Channel {
+	var channels []Channel
+	for _, address := range addresses {
+		if address != "" {
+			channels = append(channels, Channel{Type: ChannelEmail, Target: address})
+		}
+	}
+	return channels
+}
+
+// Validate checks that c names a known channel type with a usable target.
+func (c Channel) Validate() error {
+	switch c.Type {
+	case ChannelEmail:
+		// The target goes to RCPT TO as is, so display names and comments,
+		// which ParseAddress accepts, are refused.
+		address, err := mail.ParseAddress(c.Target)
+		if err != nil || address.Address != c.Target {
+			return fmt.Errorf("invalid email address %q", c.Target)
+		}
+	case ChannelWebhook, ChannelSlack:
+		u, err := url.Parse(c.Target)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid %s url %q", c.Type, c.Target)
+		}
+	case ChannelTelegram:
+		if strings.TrimSpace(c.Target) == "" {
+			return errors.New("telegram chat id is required")
+		}
+	default:
+		return fmt.Errorf("unknown notification channel %q", c.Type)
+	}
+	return nil
+}
+
+// Dispatcher builds notifiers for channels from the server-side settings
+// (SMTP account, webhook signing secret, Telegram bot token).
+type Dispatcher struct {
+	SMTP          SMTPConfig
+	WebhookSecret string
+	Telegram      TelegramConfig
+	Client        *http.Client
+}
+
+func (d *Dispatcher) client() *http.Client {
+	if d.Client != nil {
+		return d.Client
+	}
+	return defaultClient
+}
+
+// defaultClient connects to public addresses only: webhook and Slack URLs
+// are chosen by API clients, who must not reach the internal network
+// through them. Like the landing page fetcher, it ignores proxy settings
+// so the address check sees the destination host.
+var defaultClient = func() *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, Control: enrich.PublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: 15 * time.Second, Transport: transport}
+}()
+
+// For returns the notifier that delivers to c.
+func (d *Dispatcher) For(c Channel) (Notifier, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case ChannelEmail:
+		return &SMTPNotifier{Config: d.SMTP, To: []string{c.Target}}, nil
+	case ChannelWebhook:
+		return &WebhookNotifier{URL: c.Target, Secret: d.WebhookSecret, Client: d.client()}, nil
+	case ChannelSlack:
+		return &SlackNotifier{WebhookURL: c.Target, Client: d.client()}, nil
+	default: // ChannelTelegram
+		if d.Telegram.Token == "" {
+			return nil, errors.New("telegram bot token is not configured")
+		}
+		return &TelegramNotifier{Config: d.Telegram, ChatID: c.Target, Client: d.client()}, nil
+	}
+}
+
+// Send delivers n to every channel and returns the failures joined.
+func (d *Dispatcher) Send(ctx context.Context, channels []Channel, n Notification) error {
+	var errs []error
+	for _, c := range channels {
+		notifier, err := d.For(c)
+		if err == nil {
+			err = notifier.Notify(ctx, n)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", c.Type, c.Target, err))
+		}
+	}
+	return errors.Join(errs...)
+}
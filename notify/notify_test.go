@@ -0,0 +1,411 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/enrich"
+	"google-monitoring/store"
+)
+
+var testNotification = Notification{
+	Subject: "Relatório de monitoramento",
+	Text:    "2 anúncios encontrados",
+	HTML:    "<p>2 anúncios encontrados</p>",
+	Run:     &store.Run{ID: primitive.NewObjectID(), Query: "tenis corrida"},
+}
+
+// recorder is an httptest handler keeping the last request it received.
+type recorder struct {
+	path   string
+	header http.Header
+	body   []byte
+	answer string
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.path = r.URL.Path
+	rec.header = r.Header.Clone()
+	rec.body, _ = io.ReadAll(r.Body)
+	if rec.answer != "" {
+		io.WriteString(w, rec.answer)
+	}
+}
+
+func TestWebhookSignsTimestampAndBody(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL + "/hook", Secret: "s3cret", Client: server.Client()}
+	if err := n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	timestamp := rec.header.Get(TimestampHeader)
+	if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+		t.Fatalf("%s = %q, want a unix timestamp", TimestampHeader, timestamp)
+	}
+	if got, want := rec.header.Get(SignatureHeader), Sign("s3cret", timestamp, rec.body); got != want {
+		t.Errorf("%s = %q, want %q", SignatureHeader, got, want)
+	}
+	if got := rec.header.Get(SignatureHeader); got == Sign("other", timestamp, rec.body) {
+		t.Errorf("signature does not depend on the secret")
+	}
+	if got := rec.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+
+	var payload WebhookPayload
+	if err := json.Unmarshal(rec.body, &payload); err != nil {
+		t.Fatalf("body is not a webhook payload: %v", err)
+	}
+	if payload.Subject != testNotification.Subject || payload.Run == nil || payload.Run.ID != testNotification.Run.ID {
+		t.Errorf("payload = %+v, want the notification subject and run", payload)
+	}
+}
+
+func TestWebhookWithoutSecretIsUnsigned(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL, Client: server.Client()}
+	if err := n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if rec.header.Get(SignatureHeader) != "" || rec.header.Get(TimestampHeader) != "" {
+		t.Errorf("unsigned delivery carries signature headers: %v", rec.header)
+	}
+}
+
+func TestWebhookRejectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL, Client: server.Client()}
+	if err := n.Notify(context.Background(), testNotification); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("Notify error = %v, want the 403 status", err)
+	}
+}
+
+func TestSlackPayload(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := &SlackNotifier{WebhookURL: server.URL + "/services/T000/B000/XXX", Client: server.Client()}
+	if err := n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	if rec.path != "/services/T000/B000/XXX" {
+		t.Errorf("path = %q", rec.path)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(rec.body, &payload); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	want := "*" + testNotification.Subject + "*\n" + testNotification.Text
+	if len(payload) != 1 || payload["text"] != want {
+		t.Errorf("payload = %v, want only text %q", payload, want)
+	}
+}
+
+func TestSlackTruncatesLongMessages(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	long := testNotification
+	long.Text = strings.Repeat("a", 2*slackTextLimit)
+	n := &SlackNotifier{WebhookURL: server.URL, Client: server.Client()}
+	if err := n.Notify(context.Background(), long); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	var payload struct {
+		Text string `json:"text"`
+	}
+	json.Unmarshal(rec.body, &payload)
+	if n := len([]rune(payload.Text)); n != slackTextLimit || !strings.HasSuffix(payload.Text, "…") {
+		t.Errorf("text has %d runes, want %d ending with an ellipsis", n, slackTextLimit)
+	}
+}
+
+func TestTelegramSendMessage(t *testing.T) {
+	rec := &recorder{answer: `{"ok":true,"result":{}}`}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := &TelegramNotifier{
+		Config: TelegramConfig{Token: "123:abc", APIURL: server.URL + "/"},
+		ChatID: "-100200300",
+		Client: server.Client(),
+	}
+	if err := n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	if rec.path != "/bot123:abc/sendMessage" {
+		t.Errorf("path = %q, want /bot123:abc/sendMessage", rec.path)
+	}
+	var body map[string]string
+	if err := json.Unmarshal(rec.body, &body); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if body["chat_id"] != "-100200300" || body["text"] != testNotification.Subject+"\n\n"+testNotification.Text {
+		t.Errorf("body = %v", body)
+	}
+}
+
+func TestTelegramErrorHidesToken(t *testing.T) {
+	rec := &recorder{answer: `{"ok":false,"description":"Bad Request: chat not found"}`}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := &TelegramNotifier{Config: TelegramConfig{Token: "123:abc", APIURL: server.URL}, ChatID: "1", Client: server.Client()}
+	if err := n.Notify(context.Background(), testNotification); err == nil || !strings.Contains(err.Error(), "chat not found") {
+		t.Errorf("Notify error = %v, want the API description", err)
+	}
+
+	server.Close()
+	err := n.Notify(context.Background(), testNotification)
+	if err == nil || strings.Contains(err.Error(), "123:abc") {
+		t.Errorf("Notify error = %v, want an error without the bot token", err)
+	}
+}
+
+// smtpStub is a minimal SMTP server accepting a single message.
+type smtpStub struct {
+	listener net.Listener
+	commands []string
+	auth     string
+	data     string
+	done     chan struct{}
+}
+
+func newSMTPStub(t *testing.T) *smtpStub {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	stub := &smtpStub{listener: listener, done: make(chan struct{})}
+	go stub.serve()
+	t.Cleanup(func() { listener.Close() })
+	return stub
+}
+
+func (s *smtpStub) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStub) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 stub ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		s.commands = append(s.commands, strings.ToUpper(verb))
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply("250-stub")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = arg
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL", "RCPT":
+			s.commands[len(s.commands)-1] = line
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.data = data.String()
+			reply("250 OK queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPExchange(t *testing.T) {
+	stub := newSMTPStub(t)
+
+	n := &SMTPNotifier{
+		Config: SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     stub.port(),
+			Username: "monitor",
+			Password: "hunter2",
+			From:     "monitor@example.com",
+			TLS:      TLSNone,
+		},
+		To: []string{"analyst@example.com", "lead@example.com"},
+	}
+	if err := n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	<-stub.done
+
+	want := []string{"EHLO", "AUTH", "MAIL FROM:<monitor@example.com>", "RCPT TO:<analyst@example.com>", "RCPT TO:<lead@example.com>", "DATA", "QUIT"}
+	if strings.Join(stub.commands, "|") != strings.Join(want, "|") {
+		t.Errorf("commands = %q, want %q", stub.commands, want)
+	}
+
+	mechanism, credentials, _ := strings.Cut(stub.auth, " ")
+	decoded, _ := base64.StdEncoding.DecodeString(credentials)
+	if mechanism != "PLAIN" || string(decoded) != "\x00monitor\x00hunter2" {
+		t.Errorf("AUTH %s %q, want PLAIN with the configured credentials", mechanism, decoded)
+	}
+
+	if !strings.Contains(stub.data, "From: monitor@example.com\r\n") || !strings.Contains(stub.data, "To: analyst@example.com, lead@example.com\r\n") {
+		t.Errorf("message headers missing from DATA:\n%s", stub.data)
+	}
+}
+
+func TestSMTPRequiresHost(t *testing.T) {
+	n := &SMTPNotifier{Config: SMTPConfig{From: "monitor@example.com"}, To: []string{"analyst@example.com"}}
+	if err := n.Notify(context.Background(), testNotification); err == nil {
+		t.Error("Notify succeeded without an SMTP host")
+	}
+}
+
+func TestDispatcherSendJoinsFailures(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	d := &Dispatcher{Client: server.Client()}
+	err := d.Send(context.Background(), []Channel{
+		{Type: ChannelSlack, Target: server.URL},
+		{Type: ChannelTelegram, Target: "1"},
+	}, testNotification)
+	if rec.body == nil {
+		t.Error("slack channel was not delivered")
+	}
+	if err == nil || !strings.Contains(err.Error(), "telegram bot token is not configured") {
+		t.Errorf("Send error = %v, want the telegram failure", err)
+	}
+}
+
+func TestChannelValidate(t *testing.T) {
+	tests := []struct {
+		channel Channel
+		valid   bool
+	}{
+		{Channel{Type: ChannelEmail, Target: "analyst@example.com"}, true},
+		{Channel{Type: ChannelEmail, Target: "Analyst <analyst@example.com>"}, false},
+		{Channel{Type: ChannelEmail, Target: "analyst@example.com (Analyst)"}, false},
+		{Channel{Type: ChannelEmail, Target: " analyst@example.com"}, false},
+		{Channel{Type: ChannelEmail, Target: "analyst"}, false},
+		{Channel{Type: ChannelWebhook, Target: "https://hooks.example.com/run"}, true},
+		{Channel{Type: ChannelWebhook, Target: "ftp://hooks.example.com/run"}, false},
+		{Channel{Type: ChannelSlack, Target: "https://"}, false},
+		{Channel{Type: ChannelTelegram, Target: "-100123"}, true},
+		{Channel{Type: ChannelTelegram, Target: " "}, false},
+		{Channel{Type: "pager", Target: "x"}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.channel.Validate(); (err == nil) != tt.valid {
+			t.Errorf("Validate(%s %q) = %v, want valid %v", tt.channel.Type, tt.channel.Target, err, tt.valid)
+		}
+	}
+}
+
+func TestSMTPGivesUpOnStalledServer(t *testing.T) {
+	// The listener accepts connections but never greets.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	n := &SMTPNotifier{
+		Config: SMTPConfig{Host: "127.0.0.1", Port: listener.Addr().(*net.TCPAddr).Port, From: "monitor@example.com", TLS: TLSNone},
+		To:     []string{"analyst@example.com"},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = n.Notify(ctx, testNotification)
+	if err == nil {
+		t.Fatal("Notify succeeded against a server that never answers")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Notify returned after %s, want it to stop at the context deadline", elapsed)
+	}
+}
+
+func TestDispatcherRefusesInternalAddresses(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	d := &Dispatcher{}
+	for _, c := range []Channel{
+		{Type: ChannelWebhook, Target: server.URL + "/hook"},
+		{Type: ChannelSlack, Target: "http://169.254.169.254/latest/meta-data/"},
+		{Type: ChannelWebhook, Target: "http://10.0.0.1/hook"},
+	} {
+		err := d.Send(context.Background(), []Channel{c}, testNotification)
+		if !errors.Is(err, enrich.ErrBlockedAddress) {
+			t.Errorf("Send to %s: error = %v, want ErrBlockedAddress", c.Target, err)
+		}
+	}
+	if rec.body != nil {
+		t.Error("the loopback webhook was delivered")
+	}
+}
@@ -0,0 +1,36 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// slackTextLimit keeps messages under Slack's 40,000 character limit.
+const slackTextLimit = 39000
+
+// SlackNotifier posts notifications to a Slack-compatible incoming webhook.
+type SlackNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, notification Notification) error {
+	text := truncate("*"+notification.Subject+"*\n"+notification.Text, slackTextLimit)
+
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return postJSON(ctx, n.Client, n.WebhookURL, nil, body)
+}
+
+// truncate cuts s to at most limit runes, marking the cut.
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
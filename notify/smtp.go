@@ -0,0 +1,124 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// smtpTimeout bounds a whole SMTP delivery, from dialing to QUIT, when the
+// context given to Notify has no earlier deadline.
+const smtpTimeout = time.Minute
+
+// TLS modes for SMTP connections.
+const (
+	TLSStartTLS = "starttls"
+	TLSImplicit = "tls"
+	TLSNone     = "none"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// TLS is one of TLSStartTLS (default), TLSImplicit or TLSNone.
+	TLS string
+}
+
+// SMTPNotifier emails notifications through any SMTP server.
+type SMTPNotifier struct {
+	Config SMTPConfig
+	To     []string
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notification Notification) error {
+	msg, err := BuildMessage(n.Config.From, n.To, notification)
+	if err != nil {
+		return fmt.Errorf("failed to build email: %w", err)
+	}
+	return n.send(ctx, msg)
+}
+
+// send delivers a complete RFC 5322 message to n.To. It gives up when ctx
+// is done or smtpTimeout has passed, whichever comes first.
+func (n *SMTPNotifier) send(ctx context.Context, msg []byte) error {
+	cfg := n.Config
+	if cfg.Host == "" {
+		return errors.New("smtp host is not configured")
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	tlsConfig := &tls.Config{ServerName: cfg.Host}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+	dialer := &net.Dialer{}
+
+	var conn net.Conn
+	var err error
+	switch cfg.TLS {
+	case TLSImplicit:
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	case "", TLSStartTLS, TLSNone:
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	default:
+		return fmt.Errorf("unknown smtp tls mode %q", cfg.TLS)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	// The SMTP client has no context support: the deadline stops a stalled
+	// server, and closing the connection stops a cancelled delivery.
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer client.Close()
+
+	if cfg.TLS == "" || cfg.TLS == TLSStartTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(cfg.From); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	for _, to := range n.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("failed to send email to %s: %w", to, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultTelegramAPIURL = "https://api.telegram.org"
+	// telegramTextLimit is the Bot API limit for a single message.
+	telegramTextLimit = 4096
+)
+
+type TelegramConfig struct {
+	Token string
+	// APIURL defaults to DefaultTelegramAPIURL.
+	APIURL string
+}
+
+// TelegramNotifier sends notifications through the Telegram Bot API.
+type TelegramNotifier struct {
+	Config TelegramConfig
+	ChatID string
+	Client *http.Client
+}
+
+func (n *TelegramNotifier) Notify(ctx context.Context, notification Notification) error {
+	apiURL := n.Config.APIURL
+	if apiURL == "" {
+		apiURL = DefaultTelegramAPIURL
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"chat_id": n.ChatID,
+		"text":    truncate(notification.Subject+"\n\n"+notification.Text, telegramTextLimit),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode telegram message: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/bot" + n.Config.Token + "/sendMessage"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		// The request URL carries the bot token; keep it out of the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telegram request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode telegram response (%s): %w", resp.Status, err)
+	}
+	if !result.OK {
+		return fmt.Errorf("telegram rejected the message: %s", result.Description)
+	}
+	return nil
+}
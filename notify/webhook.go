@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google-monitoring/store"
+)
+
+// Headers set on signed webhook deliveries. The signature is the hex HMAC-SHA256
+// of "<timestamp>.<body>" with the shared secret, prefixed with "sha256=".
+const (
+	TimestampHeader = "X-Monitoring-Timestamp"
+	SignatureHeader = "X-Monitoring-Signature"
+)
+
+// WebhookPayload is the JSON body posted to generic webhooks.
+type WebhookPayload struct {
+	Subject      string               `json:"subject"`
+	Text         string               `json:"text"`
+	Run          *store.Run           `json:"run"`
+	Observations []*store.Observation `json:"observations"`
+	Changes      []store.AdChange     `json:"changes"`
+}
+
+// WebhookNotifier posts notifications as JSON, signed when Secret is set.
+type WebhookNotifier struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(WebhookPayload{
+		Subject:      notification.Subject,
+		Text:         notification.Text,
+		Run:          notification.Run,
+		Observations: notification.Observations,
+		Changes:      notification.Changes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	header := http.Header{}
+	if n.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		header.Set(TimestampHeader, timestamp)
+		header.Set(SignatureHeader, Sign(n.Secret, timestamp, body))
+	}
+
+	return postJSON(ctx, n.Client, n.URL, header, body)
+}
+
+// Sign computes the SignatureHeader value for a delivery.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postJSON posts body and treats any non-2xx answer as an error.
+func postJSON(ctx context.Context, client *http.Client, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(snippet))
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package providers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNotCached is returned by CachingProvider for lookups limited to the
+// cache with CacheOnly that the cache cannot answer.
+var ErrNotCached = errors.New("results are not cached")
+
+type cacheOnlyKey struct{}
+
+// CacheOnly returns a context under which CachingProvider answers from its
+// cache or fails with ErrNotCached, never spending credits.
+func CacheOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheOnlyKey{}, true)
+}
+
+func cacheOnly(ctx context.Context) bool {
+	only, _ := ctx.Value(cacheOnlyKey{}).(bool)
+	return only
+}
+
+// CachedResponse is a raw provider response kept in a Cache.
+type CachedResponse struct {
+	Raw       []byte
+	FetchedAt time.Time
+}
+
+// Cache stores raw responses by CacheKey. Get reports a miss with false.
+type Cache interface {
+	Get(ctx context.Context, key string) (*CachedResponse, bool, error)
+	Set(ctx context.Context, key string, response CachedResponse) error
+}
+
+// CacheKey identifies the results page req asks for. Queries differing only
+// in case or spacing share a key, and an empty device is the desktop one.
+func CacheKey(req SerpRequest) string {
+	device := strings.ToLower(strings.TrimSpace(req.Device))
+	if device == "" {
+		device = "desktop"
+	}
+	locale := req.locale()
+
+	parts := []string{
+		strings.Join(strings.Fields(strings.ToLower(req.Query)), " "),
+		strings.ToLower(strings.TrimSpace(req.Location)),
+		device,
+		strings.ToLower(locale.GoogleDomain),
+		strings.ToLower(locale.Country),
+		strings.ToLower(locale.Language),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// CachingProvider answers repeated lookups from Cache for TTL. Cached results
+// are marked as such and cost no credits.
+type CachingProvider struct {
+	Provider SerpProvider
+	Cache    Cache
+	TTL      time.Duration
+}
+
+// Cached reports whether the cache holds fresh results for req, so that
+// looking it up would cost nothing.
+func (p *CachingProvider) Cached(ctx context.Context, req SerpRequest) bool {
+	cached, ok, err := p.Cache.Get(ctx, CacheKey(req))
+	return err == nil && ok && time.Since(cached.FetchedAt) < p.TTL
+}
+
+func (p *CachingProvider) Search(ctx context.Context, req SerpRequest) (*SerpResults, error) {
+	key := CacheKey(req)
+
+	cached, ok, err := p.Cache.Get(ctx, key)
+	if err != nil {
+		fmt.Printf("SERP cache lookup failed: %v\n", err)
+	}
+	if ok && time.Since(cached.FetchedAt) < p.TTL {
+		results, err := ParseSerpAPI(cached.Raw)
+		if err == nil {
+			results.Raw = cached.Raw
+			results.FetchedAt = cached.FetchedAt
+			results.Cached = true
+			return results, nil
+		}
+		fmt.Printf("Ignoring unreadable cached SERP response: %v\n", err)
+	}
+	if cacheOnly(ctx) {
+		return nil, ErrNotCached
+	}
+
+	results, err := p.Provider.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Raw) > 0 {
+		response := CachedResponse{Raw: results.Raw, FetchedAt: time.Now().UTC()}
+		if err := p.Cache.Set(context.WithoutCancel(ctx), key, response); err != nil {
+			fmt.Printf("Failed to cache SERP response: %v\n", err)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,161 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+const cachedSERP = `{"ads":[{"position":1,"title":"Tênis Passada","link":"https://passada.com.br/"}],"organic_results":[{"position":1,"title":"Loja","link":"https://loja.com/"}]}`
+
+// mapCache is a Cache without eviction.
+type mapCache map[string]CachedResponse
+
+func (c mapCache) Get(_ context.Context, key string) (*CachedResponse, bool, error) {
+	response, ok := c[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return &response, true, nil
+}
+
+func (c mapCache) Set(_ context.Context, key string, response CachedResponse) error {
+	c[key] = response
+	return nil
+}
+
+// countingProvider answers every lookup with cachedSERP for one credit.
+type countingProvider struct {
+	calls int
+}
+
+func (p *countingProvider) Search(context.Context, SerpRequest) (*SerpResults, error) {
+	p.calls++
+	results, err := ParseSerpAPI([]byte(cachedSERP))
+	if err != nil {
+		return nil, err
+	}
+	results.Raw = []byte(cachedSERP)
+	results.Credits = 1
+	return results, nil
+}
+
+func TestCacheKey(t *testing.T) {
+	base := SerpRequest{Query: "tenis corrida", Location: "Jau,State of Sao Paulo,Brazil", Device: "desktop"}
+	key := CacheKey(base)
+
+	same := []SerpRequest{
+		{Query: "Tenis Corrida", Location: base.Location, Device: "desktop"},
+		{Query: "  tenis   corrida ", Location: base.Location, Device: "desktop"},
+		{Query: "tenis\tcorrida", Location: base.Location, Device: "desktop"},
+		{Query: base.Query, Location: " jau,state of sao paulo,brazil ", Device: "desktop"},
+		{Query: base.Query, Location: base.Location, Device: ""},
+		{Query: base.Query, Location: base.Location, Device: " Desktop "},
+		{Query: base.Query, Location: base.Location, Device: "desktop", Locale: DefaultLocale},
+	}
+	for _, req := range same {
+		if got := CacheKey(req); got != key {
+			t.Errorf("CacheKey(%+v) differs from CacheKey(%+v)", req, base)
+		}
+	}
+
+	different := []SerpRequest{
+		{Query: "tenis de corrida", Location: base.Location, Device: "desktop"},
+		{Query: base.Query, Location: "Bauru,State of Sao Paulo,Brazil", Device: "desktop"},
+		{Query: base.Query, Location: base.Location, Device: "mobile"},
+		{Query: base.Query, Location: base.Location, Device: "desktop", Locale: Locale{GoogleDomain: "google.com", Country: "us", Language: "en"}},
+	}
+	for _, req := range different {
+		if got := CacheKey(req); got == key {
+			t.Errorf("CacheKey(%+v) equals CacheKey(%+v)", req, base)
+		}
+	}
+}
+
+func TestCachingProviderAnswersRepeatedLookups(t *testing.T) {
+	provider := &countingProvider{}
+	cache := mapCache{}
+	p := &CachingProvider{Provider: provider, Cache: cache, TTL: time.Hour}
+	req := SerpRequest{Query: "tenis", Location: "Jau,State of Sao Paulo,Brazil"}
+
+	first, err := p.Search(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if first.Cached || first.Credits != 1 {
+		t.Errorf("first lookup: cached %v, %d credits; want a paid miss", first.Cached, first.Credits)
+	}
+	if _, ok := cache[CacheKey(req)]; !ok {
+		t.Fatal("the response was not cached")
+	}
+
+	second, err := p.Search(context.Background(), SerpRequest{Query: " TENIS ", Location: req.Location, Device: "desktop"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if !second.Cached || second.Credits != 0 || provider.calls != 1 {
+		t.Errorf("second lookup: cached %v, %d credits, %d provider calls; want a free hit", second.Cached, second.Credits, provider.calls)
+	}
+	if len(second.Ads) != 1 || len(second.Organic) != 1 || second.FetchedAt.IsZero() {
+		t.Errorf("cached results = %d ads, %d organic, fetched at %s", len(second.Ads), len(second.Organic), second.FetchedAt)
+	}
+}
+
+func TestCachingProviderExpiresEntries(t *testing.T) {
+	provider := &countingProvider{}
+	req := SerpRequest{Query: "tenis", Location: "Jau,State of Sao Paulo,Brazil"}
+	cache := mapCache{CacheKey(req): {Raw: []byte(cachedSERP), FetchedAt: time.Now().Add(-2 * time.Hour)}}
+	p := &CachingProvider{Provider: provider, Cache: cache, TTL: time.Hour}
+
+	if p.Cached(context.Background(), req) {
+		t.Error("Cached reports an expired entry")
+	}
+	results, err := p.Search(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if results.Cached || provider.calls != 1 {
+		t.Errorf("expired entry: cached %v, %d provider calls; want a fresh lookup", results.Cached, provider.calls)
+	}
+	if time.Since(cache[CacheKey(req)].FetchedAt) > time.Minute {
+		t.Error("the expired entry was not replaced")
+	}
+	if !p.Cached(context.Background(), req) {
+		t.Error("Cached does not report the fresh entry")
+	}
+}
+
+func TestCachingProviderIgnoresUnreadableEntries(t *testing.T) {
+	provider := &countingProvider{}
+	req := SerpRequest{Query: "tenis", Location: "Jau,State of Sao Paulo,Brazil"}
+	cache := mapCache{CacheKey(req): {Raw: []byte("{not json"), FetchedAt: time.Now()}}
+	p := &CachingProvider{Provider: provider, Cache: cache, TTL: time.Hour}
+
+	results, err := p.Search(context.Background(), req)
+	if err != nil || results.Cached || provider.calls != 1 {
+		t.Errorf("unreadable entry: %v, cached %v, %d provider calls; want a fresh lookup", err, results != nil && results.Cached, provider.calls)
+	}
+}
+
+func TestCachingProviderCacheOnly(t *testing.T) {
+	provider := &countingProvider{}
+	p := &CachingProvider{Provider: provider, Cache: mapCache{}, TTL: time.Hour}
+	req := SerpRequest{Query: "tenis", Location: "Jau,State of Sao Paulo,Brazil"}
+	ctx := CacheOnly(context.Background())
+
+	if _, err := p.Search(ctx, req); !errors.Is(err, ErrNotCached) {
+		t.Errorf("cache-only miss: error = %v, want ErrNotCached", err)
+	}
+	if provider.calls != 0 {
+		t.Fatal("a cache-only lookup reached the provider")
+	}
+
+	if _, err := p.Search(context.Background(), req); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	results, err := p.Search(ctx, req)
+	if err != nil || !results.Cached || provider.calls != 1 {
+		t.Errorf("cache-only hit: %v, %d provider calls; want the cached results", err, provider.calls)
+	}
+}
@@ -0,0 +1,80 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// FixtureProvider serves recorded SerpAPI responses from a directory, so the
+// whole pipeline can run offline.
+//
+// For a request it looks, in order, for:
+//
+//	<query>_<location>_<device>.json
+//	<query>_<location>.json
+//	<query>.json
+//	default.json
+//
+// where every part is lower-cased and reduced to [a-z0-9-].
+type FixtureProvider struct {
+	Dir string
+}
+
+func NewFixtureProvider(dir string) *FixtureProvider {
+	return &FixtureProvider{Dir: dir}
+}
+
+func (p *FixtureProvider) Search(ctx context.Context, req SerpRequest) (*SerpResults, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	query, location, device := slug(req.Query), slug(req.Location), slug(req.Device)
+	candidates := []string{
+		query + "_" + location + "_" + device + ".json",
+		query + "_" + location + ".json",
+		query + ".json",
+		"default.json",
+	}
+
+	for _, name := range candidates {
+		data, err := os.ReadFile(filepath.Join(p.Dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture %s: %w", name, err)
+		}
+		results, err := ParseSerpAPI(data)
+		if err != nil {
+			return nil, err
+		}
+		results.Raw = data
+		return results, nil
+	}
+
+	return nil, fmt.Errorf("no fixture found in %s for query %q at %q", p.Dir, req.Query, req.Location)
+}
+
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,87 @@
+package providers
+
+import (
+	"context"
+	"time"
+)
+
+// Locale holds the Google locale parameters sent along with every SERP lookup.
+type Locale struct {
+	GoogleDomain string `json:"google_domain"`
+	Country      string `json:"gl"`
+	Language     string `json:"hl"`
+}
+
+// DefaultLocale is the Brazilian locale the monitoring has always used.
+var DefaultLocale = Locale{
+	GoogleDomain: "google.com.br",
+	Country:      "br",
+	Language:     "pt-br",
+}
+
+// SerpRequest describes a single SERP lookup for one location.
+type SerpRequest struct {
+	Query    string
+	Location string
+	Device   string
+	Locale   Locale
+}
+
+// ResultKind tells whether a result was a paid placement or an organic listing.
+type ResultKind string
+
+const (
+	KindAd      ResultKind = "ad"
+	KindOrganic ResultKind = "organic"
+)
+
+// Ad blocks on the results page.
+const (
+	BlockTop    = "top"
+	BlockBottom = "bottom"
+)
+
+type Sitelink struct {
+	Title string `json:"title" bson:"title"`
+	Link  string `json:"link" bson:"link"`
+}
+
+// SerpResult is a single ad or organic result returned by a SERP provider.
+// AdvertiserDomain is the registrable domain the result leads to.
+type SerpResult struct {
+	Kind             ResultKind `json:"kind" bson:"kind"`
+	Position         int        `json:"position" bson:"position"`
+	Block            string     `json:"block,omitempty" bson:"block,omitempty"`
+	Title            string     `json:"title" bson:"title"`
+	Description      string     `json:"description" bson:"description"`
+	Link             string     `json:"link" bson:"link"`
+	DisplayedURL     string     `json:"displayed_url" bson:"displayed_url"`
+	TrackingLink     string     `json:"tracking_link,omitempty" bson:"tracking_link,omitempty"`
+	AdvertiserDomain string     `json:"advertiser_domain" bson:"advertiser_domain"`
+	Sitelinks        []Sitelink `json:"sitelinks,omitempty" bson:"sitelinks,omitempty"`
+}
+
+// SerpResults keeps the ads and organic results of a lookup separate. Raw
+// is the provider's response as received, kept for archiving; FetchedAt is
+// set when the results were not fetched just now, e.g. on replay or when
+// Cached. Credits is what the lookup cost with the provider.
+type SerpResults struct {
+	Ads       []SerpResult `json:"ads"`
+	Organic   []SerpResult `json:"organic_results"`
+	Raw       []byte       `json:"-"`
+	FetchedAt time.Time    `json:"-"`
+	Credits   int          `json:"-"`
+	Cached    bool         `json:"-"`
+}
+
+// SerpProvider is implemented by every SERP backend (SerpAPI, fixtures, ...).
+type SerpProvider interface {
+	Search(ctx context.Context, req SerpRequest) (*SerpResults, error)
+}
+
+func (req SerpRequest) locale() Locale {
+	if req.Locale == (Locale{}) {
+		return DefaultLocale
+	}
+	return req.Locale
+}
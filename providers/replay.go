@@ -0,0 +1,38 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ArchivedResponse is a raw SerpAPI response kept from an earlier lookup.
+type ArchivedResponse struct {
+	Raw       []byte
+	FetchedAt time.Time
+}
+
+// ReplayProvider answers lookups with archived SerpAPI responses, keyed by
+// location, so history can be re-parsed without spending credits.
+type ReplayProvider struct {
+	Responses map[string]ArchivedResponse
+}
+
+func (p *ReplayProvider) Search(ctx context.Context, req SerpRequest) (*SerpResults, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	archived, ok := p.Responses[req.Location]
+	if !ok {
+		return nil, fmt.Errorf("no archived response for %q", req.Location)
+	}
+
+	results, err := ParseSerpAPI(archived.Raw)
+	if err != nil {
+		return nil, err
+	}
+	results.Raw = archived.Raw
+	results.FetchedAt = archived.FetchedAt
+	return results, nil
+}
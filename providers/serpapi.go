@@ -0,0 +1,54 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	g "github.com/serpapi/google-search-results-golang"
+)
+
+// SerpAPIProvider fetches results from serpapi.com.
+type SerpAPIProvider struct {
+	APIKey string
+}
+
+func NewSerpAPIProvider(apiKey string) *SerpAPIProvider {
+	return &SerpAPIProvider{APIKey: apiKey}
+}
+
+func (p *SerpAPIProvider) Search(ctx context.Context, req SerpRequest) (*SerpResults, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	locale := req.locale()
+	parameter := map[string]string{
+		"engine":        "google",
+		"location":      req.Location,
+		"q":             req.Query,
+		"google_domain": locale.GoogleDomain,
+		"gl":            locale.Country,
+		"hl":            locale.Language,
+		"device":        req.Device,
+	}
+
+	search := g.NewGoogleSearch(parameter, p.APIKey)
+	raw, err := search.GetJSON()
+	if err != nil {
+		return nil, fmt.Errorf("serpapi search failed: %w", err)
+	}
+
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-encode serpapi response: %w", err)
+	}
+
+	results, err := ParseSerpAPI(rawJSON)
+	if err != nil {
+		return nil, err
+	}
+	results.Raw = rawJSON
+	results.Credits = 1
+	return results, nil
+}
@@ -0,0 +1,96 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google-monitoring/domains"
+)
+
+// serpAPIResponse mirrors the parts of a SerpAPI google response we use.
+type serpAPIResponse struct {
+	Ads            []serpAPIResult `json:"ads"`
+	OrganicResults []serpAPIResult `json:"organic_results"`
+}
+
+type serpAPIResult struct {
+	Position      int              `json:"position"`
+	BlockPosition string           `json:"block_position"`
+	Title         string           `json:"title"`
+	Link          string           `json:"link"`
+	DisplayedLink string           `json:"displayed_link"`
+	TrackingLink  string           `json:"tracking_link"`
+	Description   string           `json:"description"`
+	Snippet       string           `json:"snippet"`
+	Sitelinks     serpAPISitelinks `json:"sitelinks"`
+}
+
+// serpAPISitelinks accepts both shapes SerpAPI uses for sitelinks: a plain
+// list on ads, and an object with inline/expanded lists on organic results.
+type serpAPISitelinks []Sitelink
+
+func (s *serpAPISitelinks) UnmarshalJSON(data []byte) error {
+	var list []Sitelink
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+
+	var grouped struct {
+		Inline   []Sitelink `json:"inline"`
+		Expanded []Sitelink `json:"expanded"`
+	}
+	if err := json.Unmarshal(data, &grouped); err != nil {
+		return err
+	}
+	*s = append(grouped.Expanded, grouped.Inline...)
+	return nil
+}
+
+// ParseSerpAPI decodes a raw SerpAPI JSON response into SerpResults.
+func ParseSerpAPI(rawJSON []byte) (*SerpResults, error) {
+	var raw serpAPIResponse
+	if err := json.Unmarshal(rawJSON, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode serpapi response: %w", err)
+	}
+
+	results := &SerpResults{}
+	for _, ad := range raw.Ads {
+		results.Ads = append(results.Ads, ad.toResult(KindAd))
+	}
+	for _, organic := range raw.OrganicResults {
+		results.Organic = append(results.Organic, organic.toResult(KindOrganic))
+	}
+	return results, nil
+}
+
+func (r serpAPIResult) toResult(kind ResultKind) SerpResult {
+	result := SerpResult{
+		Kind:         kind,
+		Position:     r.Position,
+		Title:        r.Title,
+		Description:  r.Description,
+		Link:         r.Link,
+		DisplayedURL: r.DisplayedLink,
+		TrackingLink: r.TrackingLink,
+		Sitelinks:    r.Sitelinks,
+	}
+
+	if result.Description == "" {
+		result.Description = r.Snippet
+	}
+
+	if kind == KindAd {
+		result.Block = BlockTop
+		if r.BlockPosition == BlockBottom {
+			result.Block = BlockBottom
+		}
+	}
+
+	result.AdvertiserDomain = domains.Advertiser(r.Link)
+	if result.AdvertiserDomain == "" {
+		result.AdvertiserDomain = domains.Advertiser(r.DisplayedLink)
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+// Package ratelimit holds the token bucket stores of middleware.RateLimit:
+// one in memory for a single instance and one in MongoDB shared by all of
+// them.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google-monitoring/middleware"
+)
+
+// pruneEvery is how often Memory forgets the buckets that refilled.
+const pruneEvery = time.Minute
+
+// Memory keeps the buckets of this instance only.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	pruned  time.Time
+}
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+	// full is when the bucket is back to its capacity.
+	full time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{buckets: map[string]*memoryBucket{}, pruned: time.Now()}
+}
+
+func (m *Memory) Take(ctx context.Context, key string, limit middleware.Limit) (float64, bool, error) {
+	now := time.Now()
+	capacity := float64(limit.Requests)
+	rate := limit.Rate()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.pruned) >= pruneEvery {
+		for k, b := range m.buckets {
+			if !now.Before(b.full) {
+				delete(m.buckets, k)
+			}
+		}
+		m.pruned = now
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: capacity, updated: now}
+		m.buckets[key] = b
+	}
+	b.tokens = min(capacity, b.tokens+now.Sub(b.updated).Seconds()*rate)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	b.full = now.Add(time.Duration((capacity - b.tokens) / rate * float64(time.Second)))
+	return b.tokens, allowed, nil
+}
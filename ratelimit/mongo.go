@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/middleware"
+)
+
+const bucketsCollection = "rate_limits"
+
+// Mongo keeps the buckets in MongoDB so limits hold across instances. Every
+// take is a single atomic update timed by the database clock, and a TTL
+// index drops the buckets once they have refilled.
+type Mongo struct {
+	collection *mongo.Collection
+}
+
+type mongoBucket struct {
+	Tokens  float64 `bson:"tokens"`
+	Allowed bool    `bson:"allowed"`
+}
+
+func NewMongo(client *mongo.Client, dbName string) *Mongo {
+	return &Mongo{collection: client.Database(dbName).Collection(bucketsCollection)}
+}
+
+func (m *Mongo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", bucketsCollection, err)
+	}
+	return nil
+}
+
+func (m *Mongo) Take(ctx context.Context, key string, limit middleware.Limit) (float64, bool, error) {
+	capacity := float64(limit.Requests)
+	perMilli := limit.Rate() / 1000
+
+	refilled := bson.M{"$min": bson.A{capacity, bson.M{"$add": bson.A{
+		bson.M{"$ifNull": bson.A{"$tokens", capacity}},
+		bson.M{"$multiply": bson.A{
+			bson.M{"$subtract": bson.A{"$$NOW", bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}},
+			perMilli,
+		}},
+	}}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"tokens": refilled, "updated_at": "$$NOW"}}},
+		{{Key: "$set", Value: bson.M{"allowed": bson.M{"$gte": bson.A{"$tokens", 1}}}}},
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$cond": bson.A{"$allowed", bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens"}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"expires_at": bson.M{"$add": bson.A{
+				"$$NOW",
+				bson.M{"$ceil": bson.M{"$divide": bson.A{bson.M{"$subtract": bson.A{capacity, "$tokens"}}, perMilli}}},
+			}},
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var bucket mongoBucket
+	err := m.collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	if mongo.IsDuplicateKeyError(err) {
+		// Another instance created the bucket first; it exists now.
+		err = m.collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to take a token from %s: %w", key, err)
+	}
+	return bucket.Tokens, bucket.Allowed, nil
+}
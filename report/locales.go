@@ -0,0 +1,82 @@
+package report
+
+type messages map[string]string
+
+var locales = map[string]messages{
+	"pt-BR": {
+		"subject":                       "Monitoramento de Marca | Resultados",
+		"heading":                       "Resultados do monitoramento",
+		"query":                         "Consulta",
+		"device":                        "Dispositivo",
+		"started_at":                    "Início",
+		"status":                        "Situação",
+		"city":                          "Cidade",
+		"title":                         "Título",
+		"description":                   "Descrição",
+		"link":                          "Link",
+		"advertiser":                    "Anunciante",
+		"position":                      "Posição",
+		"no_results":                    "Nenhum resultado encontrado.",
+		"failed":                        "Falha na busca",
+		"total":                         "Total de resultados",
+		"attachment":                    "Todos os resultados seguem em anexo (CSV).",
+		"kind_ad":                       "Anúncio",
+		"kind_organic":                  "Orgânico",
+		"time_format":                   "02/01/2006 15:04",
+		"time_zone":                     "America/Sao_Paulo",
+		"csv_filename":                  "resultados",
+		"status_ok":                     "concluída",
+		"status_failed":                 "falhou",
+		"status_skipped":                "ignorada",
+		"infringing":                    "Anunciantes usando a marca sem autorização",
+		"infringing_ads":                "anúncios",
+		"brand_owned":                   "próprio",
+		"brand_authorized":              "revendedor autorizado",
+		"brand_infringing":              "infrator",
+		"brand_unrelated":               "sem relação",
+		"changes":                       "Mudanças nos anúncios desde a última execução",
+		"change_new_advertiser":         "novo anunciante",
+		"change_disappeared_advertiser": "anunciante sumiu",
+		"change_headline_changed":       "título alterado",
+		"change_description_changed":    "descrição alterada",
+		"change_landing_url_changed":    "página de destino alterada",
+	},
+	"en": {
+		"subject":                       "Brand Monitoring | Results",
+		"heading":                       "Monitoring results",
+		"query":                         "Query",
+		"device":                        "Device",
+		"started_at":                    "Started",
+		"status":                        "Status",
+		"city":                          "City",
+		"title":                         "Title",
+		"description":                   "Description",
+		"link":                          "Link",
+		"advertiser":                    "Advertiser",
+		"position":                      "Position",
+		"no_results":                    "No results found.",
+		"failed":                        "Search failed",
+		"total":                         "Total results",
+		"attachment":                    "All results are attached as CSV.",
+		"kind_ad":                       "Ad",
+		"kind_organic":                  "Organic",
+		"time_format":                   "2006-01-02 15:04",
+		"time_zone":                     "UTC",
+		"csv_filename":                  "results",
+		"status_ok":                     "completed",
+		"status_failed":                 "failed",
+		"status_skipped":                "skipped",
+		"infringing":                    "Advertisers bidding on the brand without authorization",
+		"infringing_ads":                "ads",
+		"brand_owned":                   "owned",
+		"brand_authorized":              "authorized reseller",
+		"brand_infringing":              "infringing",
+		"brand_unrelated":               "unrelated",
+		"changes":                       "Ad changes since the previous run",
+		"change_new_advertiser":         "new advertiser",
+		"change_disappeared_advertiser": "advertiser disappeared",
+		"change_headline_changed":       "headline changed",
+		"change_description_changed":    "description changed",
+		"change_landing_url_changed":    "landing page changed",
+	},
+}
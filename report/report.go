@@ -0,0 +1,220 @@
+package report
+
+import (
+	"bytes"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"google-monitoring/brand"
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+//go:embed templates/*
+var templates embed.FS
+
+// Report is the data the templates render: a run grouped by city, and how
+// its ads changed since the previous run.
+type Report struct {
+	Run     *store.Run
+	Cities  []City
+	Total   int
+	Changes []store.AdChange
+}
+
+type City struct {
+	Name    string
+	Status  store.ObservationStatus
+	Error   string
+	Results []store.Result
+}
+
+// Rendered is a report ready to be sent.
+type Rendered struct {
+	Subject     string
+	Text        string
+	HTML        string
+	CSV         []byte
+	CSVFilename string
+}
+
+// Renderer renders reports in one locale.
+type Renderer struct {
+	subject  string
+	messages messages
+	location *time.Location
+	text     *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// NewRenderer parses the templates for locale ("pt-BR" or "en"). The locale
+// also sets the time zone of the report and the name of its CSV. A
+// non-empty subject replaces the locale's default subject.
+func NewRenderer(locale, subject string) (*Renderer, error) {
+	msgs, ok := locales[locale]
+	if !ok {
+		return nil, fmt.Errorf("unsupported report locale %q", locale)
+	}
+	if subject == "" {
+		subject = msgs["subject"]
+	}
+
+	location, err := time.LoadLocation(msgs["time_zone"])
+	if err != nil {
+		location = time.UTC
+	}
+
+	r := &Renderer{subject: subject, messages: msgs, location: location}
+	funcs := map[string]interface{}{
+		"t":      r.translate,
+		"kind":   r.kind,
+		"when":   r.when,
+		"brand":  r.brand,
+		"join":   strings.Join,
+		"change": r.change,
+	}
+
+	r.text, err = texttemplate.New("report.txt.tmpl").Funcs(funcs).ParseFS(templates, "templates/report.txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse text report template: %w", err)
+	}
+	r.html, err = htmltemplate.New("report.html.tmpl").Funcs(funcs).ParseFS(templates, "templates/report.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html report template: %w", err)
+	}
+	return r, nil
+}
+
+// Build groups the observations of run by city, in the order of run.Cities.
+// Cities that were never observed are left out.
+func Build(run *store.Run, observations []*store.Observation, changes []store.AdChange) *Report {
+	report := &Report{Run: run, Changes: changes}
+	for _, observation := range observations {
+		if observation == nil {
+			continue
+		}
+		report.Cities = append(report.Cities, City{
+			Name:    observation.City,
+			Status:  observation.Status,
+			Error:   observation.Error,
+			Results: observation.Results,
+		})
+		report.Total += len(observation.Results)
+	}
+	return report
+}
+
+func (r *Renderer) Render(report *Report) (*Rendered, error) {
+	var text, html bytes.Buffer
+	if err := r.text.Execute(&text, report); err != nil {
+		return nil, fmt.Errorf("failed to render text report: %w", err)
+	}
+	if err := r.html.Execute(&html, report); err != nil {
+		return nil, fmt.Errorf("failed to render html report: %w", err)
+	}
+
+	csvData, err := resultsCSV(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rendered{
+		Subject:     r.subject,
+		Text:        text.String(),
+		HTML:        html.String(),
+		CSV:         csvData,
+		CSVFilename: r.translate("csv_filename") + "-" + report.Run.ID.Hex() + ".csv",
+	}, nil
+}
+
+func (r *Renderer) translate(key string) string {
+	if msg, ok := r.messages[key]; ok {
+		return msg
+	}
+	return key
+}
+
+func (r *Renderer) kind(kind providers.ResultKind) string {
+	return r.translate("kind_" + string(kind))
+}
+
+// brand names the verdict on a result, or returns "" when it has none.
+func (r *Renderer) brand(verdict *brand.Verdict) string {
+	if verdict == nil {
+		return ""
+	}
+	return r.translate("brand_" + string(verdict.Label))
+}
+
+func (r *Renderer) change(t store.ChangeType) string {
+	return r.translate("change_" + string(t))
+}
+
+func (r *Renderer) when(t time.Time) string {
+	return t.In(r.location).Format(r.translate("time_format"))
+}
+
+var csvHeader = []string{
+	"city", "kind", "position", "block", "advertiser_domain", "serp_title",
+	"serp_description", "serp_link", "displayed_url", "title", "snippet", "link",
+	"brand_label",
+}
+
+func brandLabel(verdict *brand.Verdict) string {
+	if verdict == nil {
+		return ""
+	}
+	return string(verdict.Label)
+}
+
+// csvCell neutralises cells a spreadsheet would evaluate as a formula. Ad
+// copy and links are written by advertisers, so any cell starting with one
+// of the formula triggers is prefixed with a quote.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func resultsCSV(report *Report) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write(csvHeader)
+	for _, city := range report.Cities {
+		for _, result := range city.Results {
+			record := []string{
+				city.Name,
+				string(result.Kind),
+				strconv.Itoa(result.Serp.Position),
+				result.Serp.Block,
+				result.Serp.AdvertiserDomain,
+				result.Serp.Title,
+				result.Serp.Description,
+				result.Serp.Link,
+				result.Serp.DisplayedURL,
+				result.Title,
+				result.Snippet,
+				result.Link,
+				brandLabel(result.Brand),
+			}
+			for i := range record {
+				record[i] = csvCell(record[i])
+			}
+			w.Write(record)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write results csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/providers"
+	"google-monitoring/store"
+)
+
+func TestResultsCSVNeutralisesFormulas(t *testing.T) {
+	serp := providers.SerpResult{
+		Kind:             providers.KindAd,
+		Position:         1,
+		Block:            providers.BlockTop,
+		Title:            `=HYPERLINK("http://evil.example","Clique")`,
+		Description:      "+55 11 4002-8922",
+		Link:             "https://loja.example/",
+		DisplayedURL:     "@loja.example",
+		AdvertiserDomain: "loja.example",
+	}
+	result := store.NewResult(serp)
+	result.Snippet = "-10% hoje"
+
+	run := &store.Run{ID: primitive.NewObjectID(), Query: "tenis"}
+	observations := []*store.Observation{{City: "Jau,State of Sao Paulo,Brazil", Status: store.ObservationOK, Results: []store.Result{result}}}
+
+	r, err := NewRenderer("pt-BR", "")
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+	rendered, err := r.Render(Build(run, observations, nil))
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(rendered.CSV)).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want header and one row", len(records))
+	}
+
+	row := map[string]string{}
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+	for column, want := range map[string]string{
+		"serp_title":        `'=HYPERLINK("http://evil.example","Clique")`,
+		"serp_description":  "'+55 11 4002-8922",
+		"displayed_url":     "'@loja.example",
+		"snippet":           "'-10% hoje",
+		"serp_link":         "https://loja.example/",
+		"advertiser_domain": "loja.example",
+		"position":          "1",
+	} {
+		if row[column] != want {
+			t.Errorf("%s = %q, want %q", column, row[column], want)
+		}
+	}
+	if rendered.CSVFilename != "resultados-"+run.ID.Hex()+".csv" {
+		t.Errorf("CSVFilename = %q", rendered.CSVFilename)
+	}
+}
+
+func TestRendererFollowsLocale(t *testing.T) {
+	startedAt := time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC)
+	run := &store.Run{ID: primitive.NewObjectID(), Query: "tenis", StartedAt: startedAt}
+
+	tests := []struct {
+		locale   string
+		filename string
+		started  string
+	}{
+		{"pt-BR", "resultados-" + run.ID.Hex() + ".csv", "10/03/2026 11:30"},
+		{"en", "results-" + run.ID.Hex() + ".csv", "2026-03-10 14:30"},
+	}
+	for _, tt := range tests {
+		r, err := NewRenderer(tt.locale, "")
+		if err != nil {
+			t.Fatalf("NewRenderer(%s): %v", tt.locale, err)
+		}
+		rendered, err := r.Render(Build(run, nil, nil))
+		if err != nil {
+			t.Fatalf("Render(%s): %v", tt.locale, err)
+		}
+		if rendered.CSVFilename != tt.filename {
+			t.Errorf("%s: CSVFilename = %q, want %q", tt.locale, rendered.CSVFilename, tt.filename)
+		}
+		if !strings.Contains(rendered.Text, tt.started) {
+			t.Errorf("%s: report does not show the start time as %q:\n%s", tt.locale, tt.started, rendered.Text)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google-monitoring/jobs"
+	"google-monitoring/monitor"
+	"google-monitoring/notify"
+	"google-monitoring/usage"
+)
+
+// DefaultPollInterval is how often the worker looks for due schedules.
+const DefaultPollInterval = 30 * time.Second
+
+// DefaultWorkers is how many schedules a worker runs at once by default.
+const DefaultWorkers = 4
+
+// Worker executes due schedules through the monitoring pipeline. Several
+// instances may run side by side; each occurrence is claimed by one of them.
+// A worker claims no more schedules than it has free slots, leaving the rest
+// to the other instances.
+type Worker struct {
+	store    *Store
+	runner   *jobs.Runner
+	interval time.Duration
+	slots    chan struct{}
+}
+
+func NewWorker(st *Store, runner *jobs.Runner, interval time.Duration, workers int) *Worker {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	return &Worker{store: st, runner: runner, interval: interval, slots: make(chan struct{}, workers)}
+}
+
+// Run polls for due schedules until ctx is cancelled, then waits for the
+// runs it started to finish.
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runDue(ctx, &wg)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) runDue(ctx context.Context, wg *sync.WaitGroup) {
+	for {
+		select {
+		case w.slots <- struct{}{}:
+		default:
+			return
+		}
+
+		s, err := w.store.claimDue(ctx, time.Now().UTC())
+		if err != nil || s == nil {
+			<-w.slots
+			if err != nil {
+				fmt.Printf("Scheduler: %v\n", err)
+			}
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-w.slots }()
+			w.execute(ctx, s)
+		}()
+	}
+}
+
+func (w *Worker) execute(ctx context.Context, s *Schedule) {
+	fmt.Printf("Scheduler: running schedule %s (%s)\n", s.ID.Hex(), s.Query)
+
+	outcome, err := w.runner.Run(ctx, monitor.Request{
+		Query:     s.Query,
+		Cities:    s.Cities,
+		Device:    s.Device,
+		Requester: "schedule:" + s.ID.Hex(),
+		Brand:     s.Brand,
+		Tenant:    s.Tenant,
+		Notify:    append(notify.EmailChannels(s.Recipients...), s.Notify...),
+	})
+	var budgetErr *usage.BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		fmt.Printf("Scheduler: deferring schedule %s to %s: %v\n", s.ID.Hex(), budgetErr.ResetAt.Format(time.RFC3339), err)
+		if err := w.store.deferUntil(ctx, s.ID, budgetErr.ResetAt); err != nil {
+			fmt.Printf("Scheduler: %v\n", err)
+		}
+		return
+	}
+	if err != nil {
+		fmt.Printf("Scheduler: schedule %s failed: %v\n", s.ID.Hex(), err)
+		return
+	}
+
+	if err := w.store.recordRun(ctx, s.ID, outcome.Run.ID); err != nil {
+		fmt.Printf("Scheduler: %v\n", err)
+	}
+}
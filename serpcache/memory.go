@@ -0,0 +1,65 @@
+// Package serpcache holds the backends of providers.CachingProvider: an
+// in-memory LRU, a MongoDB collection, and the two combined.
+package serpcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"google-monitoring/providers"
+)
+
+// Memory is an LRU cache holding at most Size responses.
+type Memory struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key      string
+	response providers.CachedResponse
+}
+
+func NewMemory(size int) *Memory {
+	return &Memory{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (m *Memory) Get(ctx context.Context, key string) (*providers.CachedResponse, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	m.order.MoveToFront(element)
+	response := element.Value.(*memoryEntry).response
+	return &response, true, nil
+}
+
+func (m *Memory) Set(ctx context.Context, key string, response providers.CachedResponse) error {
+	if m.size <= 0 {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		element.Value.(*memoryEntry).response = response
+		m.order.MoveToFront(element)
+		return nil
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, response: response})
+	for m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+	return nil
+}
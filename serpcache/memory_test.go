@@ -0,0 +1,91 @@
+package serpcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google-monitoring/providers"
+)
+
+func response(raw string) providers.CachedResponse {
+	return providers.CachedResponse{Raw: []byte(raw), FetchedAt: time.Now()}
+}
+
+// keys returns the keys of m from the most to the least recently used.
+func (m *Memory) keys() []string {
+	var keys []string
+	for e := m.order.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.(*memoryEntry).key)
+	}
+	return keys
+}
+
+func TestMemoryEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(3)
+
+	for _, key := range []string{"a", "b", "c"} {
+		m.Set(ctx, key, response(key))
+	}
+	// Reading a and rewriting b makes c the least recently used.
+	if _, ok, _ := m.Get(ctx, "a"); !ok {
+		t.Fatal("a is missing")
+	}
+	m.Set(ctx, "b", response("b2"))
+	m.Set(ctx, "d", response("d"))
+
+	if _, ok, _ := m.Get(ctx, "c"); ok {
+		t.Error("c was kept, want it evicted")
+	}
+	if got, want := m.keys(), []string{"d", "b", "a"}; !equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	got, ok, _ := m.Get(ctx, "b")
+	if !ok || string(got.Raw) != "b2" {
+		t.Errorf("b = %v, want the rewritten response", got)
+	}
+
+	m.Set(ctx, "e", response("e"))
+	if _, ok, _ := m.Get(ctx, "a"); ok {
+		t.Error("a was kept, want it evicted")
+	}
+	if len(m.entries) != 3 || m.order.Len() != 3 {
+		t.Errorf("holds %d entries and %d list elements, want 3", len(m.entries), m.order.Len())
+	}
+}
+
+func TestMemoryReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(1)
+	m.Set(ctx, "a", response("a"))
+
+	got, _, _ := m.Get(ctx, "a")
+	got.FetchedAt = time.Time{}
+
+	again, _, _ := m.Get(ctx, "a")
+	if again.FetchedAt.IsZero() {
+		t.Error("changing a returned response changed the cached one")
+	}
+}
+
+func TestMemoryWithoutSize(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(0)
+	m.Set(ctx, "a", response("a"))
+	if _, ok, _ := m.Get(ctx, "a"); ok {
+		t.Error("a cache of size 0 kept a response")
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package serpcache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/providers"
+)
+
+const cacheCollection = "serp_cache"
+
+// Mongo keeps responses gzip-compressed in MongoDB, where a TTL index drops
+// them once they expire. It lets several instances share a cache. The expiry
+// is stored with every entry so changing the TTL needs no index rebuild.
+type Mongo struct {
+	collection *mongo.Collection
+	ttl        time.Duration
+}
+
+type mongoEntry struct {
+	Key       string    `bson:"_id"`
+	Data      []byte    `bson:"data"`
+	FetchedAt time.Time `bson:"fetched_at"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+func NewMongo(client *mongo.Client, dbName string, ttl time.Duration) *Mongo {
+	return &Mongo{collection: client.Database(dbName).Collection(cacheCollection), ttl: ttl}
+}
+
+func (m *Mongo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", cacheCollection, err)
+	}
+	return nil
+}
+
+func (m *Mongo) Get(ctx context.Context, key string) (*providers.CachedResponse, bool, error) {
+	var entry mongoEntry
+	err := m.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&entry)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cached response: %w", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(entry.Data))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decompress cached response: %w", err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decompress cached response: %w", err)
+	}
+	return &providers.CachedResponse{Raw: raw, FetchedAt: entry.FetchedAt}, true, nil
+}
+
+func (m *Mongo) Set(ctx context.Context, key string, response providers.CachedResponse) error {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(response.Raw); err != nil {
+		return fmt.Errorf("failed to compress response: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress response: %w", err)
+	}
+
+	entry := mongoEntry{
+		Key:       key,
+		Data:      compressed.Bytes(),
+		FetchedAt: response.FetchedAt,
+		ExpiresAt: response.FetchedAt.Add(m.ttl),
+	}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := m.collection.ReplaceOne(ctx, bson.M{"_id": key}, entry, opts); err != nil {
+		return fmt.Errorf("failed to cache response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package serpcache
+
+import (
+	"context"
+
+	"google-monitoring/providers"
+)
+
+// Tiered looks responses up in each cache in turn and copies a hit into the
+// caches before it. Set writes to every cache.
+type Tiered []providers.Cache
+
+func (t Tiered) Get(ctx context.Context, key string) (*providers.CachedResponse, bool, error) {
+	for i, cache := range t {
+		response, ok, err := cache.Get(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			continue
+		}
+
+		for _, faster := range t[:i] {
+			if err := faster.Set(ctx, key, *response); err != nil {
+				return nil, false, err
+			}
+		}
+		return response, true, nil
+	}
+	return nil, false, nil
+}
+
+func (t Tiered) Set(ctx context.Context, key string, response providers.CachedResponse) error {
+	for _, cache := range t {
+		if err := cache.Set(ctx, key, response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
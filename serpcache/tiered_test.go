@@ -0,0 +1,77 @@
+package serpcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google-monitoring/providers"
+)
+
+// failingCache fails every operation.
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) (*providers.CachedResponse, bool, error) {
+	return nil, false, errors.New("cache unavailable")
+}
+
+func (failingCache) Set(context.Context, string, providers.CachedResponse) error {
+	return errors.New("cache unavailable")
+}
+
+func TestTieredCopiesHitsIntoFasterTiers(t *testing.T) {
+	ctx := context.Background()
+	fast, middle, slow := NewMemory(10), NewMemory(10), NewMemory(10)
+	tiered := Tiered{fast, middle, slow}
+
+	slow.Set(ctx, "a", response("a"))
+
+	got, ok, err := tiered.Get(ctx, "a")
+	if err != nil || !ok || string(got.Raw) != "a" {
+		t.Fatalf("Get = %v, %v, %v; want the slow tier's response", got, ok, err)
+	}
+	for name, cache := range map[string]*Memory{"fast": fast, "middle": middle} {
+		if copied, ok, _ := cache.Get(ctx, "a"); !ok || string(copied.Raw) != "a" {
+			t.Errorf("the hit was not copied into the %s tier", name)
+		}
+	}
+
+	// A hit in the first tier leaves the others alone.
+	fast.Set(ctx, "b", response("b"))
+	if _, ok, _ := tiered.Get(ctx, "b"); !ok {
+		t.Fatal("b is missing")
+	}
+	if _, ok, _ := slow.Get(ctx, "b"); ok {
+		t.Error("a fast hit was written to the slow tier")
+	}
+
+	if _, ok, err := tiered.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get(missing) = %v, %v; want a miss", ok, err)
+	}
+}
+
+func TestTieredSetWritesEveryTier(t *testing.T) {
+	ctx := context.Background()
+	fast, slow := NewMemory(10), NewMemory(10)
+
+	if err := (Tiered{fast, slow}).Set(ctx, "a", response("a")); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	for name, cache := range map[string]*Memory{"fast": fast, "slow": slow} {
+		if _, ok, _ := cache.Get(ctx, "a"); !ok {
+			t.Errorf("the %s tier does not hold a", name)
+		}
+	}
+}
+
+func TestTieredReportsFailures(t *testing.T) {
+	ctx := context.Background()
+	tiered := Tiered{NewMemory(10), failingCache{}}
+
+	if _, _, err := tiered.Get(ctx, "a"); err == nil {
+		t.Error("Get hid the failure of a tier")
+	}
+	if err := tiered.Set(ctx, "a", response("a")); err == nil {
+		t.Error("Set hid the failure of a tier")
+	}
+}
@@ -0,0 +1,212 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google-monitoring/providers"
+)
+
+// DefaultAnalyticsWindow is the period covered when no start is given.
+const DefaultAnalyticsWindow = 30 * 24 * time.Hour
+
+// AnalyticsQuery selects the observations of one query over a time window.
+// Query matches case-insensitively but in full; City and Device are optional.
+// Replays are left out, as they would count their original lookups twice.
+type AnalyticsQuery struct {
+	Tenant string
+	Query  string
+	City   string
+	Device string
+	From   time.Time
+	To     time.Time
+}
+
+// Analytics is the share of voice of every advertiser seen for a query.
+// Lookups counts the successful observations in the window: the number of
+// times the results page was looked at.
+type Analytics struct {
+	Query       string            `json:"query"`
+	From        time.Time         `json:"from"`
+	To          time.Time         `json:"to"`
+	Lookups     int               `json:"lookups"`
+	Advertisers []AdvertiserShare `json:"advertisers"`
+}
+
+// AdvertiserShare holds the metrics of one advertiser. ImpressionShare is the
+// fraction of lookups showing at least one of its ads, TopOfPageRate the
+// fraction of its ads shown above the organic results.
+type AdvertiserShare struct {
+	Domain          string         `json:"domain"`
+	Appearances     int            `json:"appearances"`
+	Ads             int            `json:"ads"`
+	ImpressionShare float64        `json:"impression_share"`
+	AveragePosition float64        `json:"average_position"`
+	TopOfPageRate   float64        `json:"top_of_page_rate"`
+	Cities          []CityPresence `json:"cities"`
+}
+
+// CityPresence is the fraction of a city's lookups in which an advertiser
+// showed up.
+type CityPresence struct {
+	City        string  `json:"city"`
+	Appearances int     `json:"appearances"`
+	Lookups     int     `json:"lookups"`
+	Presence    float64 `json:"presence"`
+}
+
+// analyticsFacets is the decoded output of the aggregation in Analytics.
+type analyticsFacets struct {
+	Lookups []struct {
+		City    string `bson:"_id"`
+		Lookups int    `bson:"lookups"`
+	} `bson:"lookups"`
+	Advertisers []struct {
+		ID struct {
+			Domain string `bson:"domain"`
+			City   string `bson:"city"`
+		} `bson:"_id"`
+		Appearances int `bson:"appearances"`
+		Ads         int `bson:"ads"`
+		PositionSum int `bson:"position_sum"`
+		Top         int `bson:"top"`
+	} `bson:"advertisers"`
+}
+
+// Analytics computes the share of voice of the advertisers shown for q.Query.
+// A zero To means now and a zero From means DefaultAnalyticsWindow before To.
+func (s *Store) Analytics(ctx context.Context, q AnalyticsQuery) (*Analytics, error) {
+	if q.To.IsZero() {
+		q.To = time.Now().UTC()
+	}
+	if q.From.IsZero() {
+		q.From = q.To.Add(-DefaultAnalyticsWindow)
+	}
+
+	match := bson.M{
+		"tenant":      tenantScope(q.Tenant),
+		"query":       primitive.Regex{Pattern: "^" + regexp.QuoteMeta(q.Query) + "$", Options: "i"},
+		"status":      ObservationOK,
+		"replay_of":   nil,
+		"observed_at": bson.M{"$gte": q.From, "$lte": q.To},
+	}
+	if q.City != "" {
+		match["city"] = q.City
+	}
+	if q.Device != "" {
+		match["device"] = q.Device
+	}
+
+	// Ads are first grouped per observation so an advertiser with several
+	// ads on one page counts a single appearance, then per city.
+	pipeline := bson.A{
+		bson.M{"$match": match},
+		bson.M{"$facet": bson.M{
+			"lookups": bson.A{
+				bson.M{"$group": bson.M{"_id": "$city", "lookups": bson.M{"$sum": 1}}},
+			},
+			"advertisers": bson.A{
+				bson.M{"$unwind": "$results"},
+				bson.M{"$match": bson.M{"results.kind": providers.KindAd}},
+				bson.M{"$group": bson.M{
+					"_id": bson.M{
+						"domain":      "$results.serp.advertiser_domain",
+						"observation": "$_id",
+						"city":        "$city",
+					},
+					"ads":          bson.M{"$sum": 1},
+					"position_sum": bson.M{"$sum": "$results.serp.position"},
+					"top": bson.M{"$sum": bson.M{"$cond": bson.A{
+						bson.M{"$eq": bson.A{"$results.serp.block", providers.BlockTop}}, 1, 0,
+					}}},
+				}},
+				bson.M{"$group": bson.M{
+					"_id":          bson.M{"domain": "$_id.domain", "city": "$_id.city"},
+					"appearances":  bson.M{"$sum": 1},
+					"ads":          bson.M{"$sum": "$ads"},
+					"position_sum": bson.M{"$sum": "$position_sum"},
+					"top":          bson.M{"$sum": "$top"},
+				}},
+			},
+		}},
+	}
+
+	cursor, err := s.observations().Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate analytics: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var facets []analyticsFacets
+	if err := cursor.All(ctx, &facets); err != nil {
+		return nil, fmt.Errorf("failed to decode analytics: %w", err)
+	}
+
+	analytics := &Analytics{Query: q.Query, From: q.From, To: q.To, Advertisers: []AdvertiserShare{}}
+	if len(facets) == 0 {
+		return analytics, nil
+	}
+
+	lookupsByCity := map[string]int{}
+	for _, city := range facets[0].Lookups {
+		lookupsByCity[city.City] = city.Lookups
+		analytics.Lookups += city.Lookups
+	}
+
+	type totals struct {
+		share       AdvertiserShare
+		positionSum int
+		top         int
+	}
+	byDomain := map[string]*totals{}
+	for _, row := range facets[0].Advertisers {
+		t, ok := byDomain[row.ID.Domain]
+		if !ok {
+			t = &totals{share: AdvertiserShare{Domain: row.ID.Domain}}
+			byDomain[row.ID.Domain] = t
+		}
+		t.share.Appearances += row.Appearances
+		t.share.Ads += row.Ads
+		t.positionSum += row.PositionSum
+		t.top += row.Top
+
+		lookups := lookupsByCity[row.ID.City]
+		t.share.Cities = append(t.share.Cities, CityPresence{
+			City:        row.ID.City,
+			Appearances: row.Appearances,
+			Lookups:     lookups,
+			Presence:    ratio(row.Appearances, lookups),
+		})
+	}
+
+	for _, t := range byDomain {
+		share := t.share
+		share.ImpressionShare = ratio(share.Appearances, analytics.Lookups)
+		share.AveragePosition = ratio(t.positionSum, share.Ads)
+		share.TopOfPageRate = ratio(t.top, share.Ads)
+		sort.Slice(share.Cities, func(i, j int) bool { return share.Cities[i].City < share.Cities[j].City })
+		analytics.Advertisers = append(analytics.Advertisers, share)
+	}
+	sort.Slice(analytics.Advertisers, func(i, j int) bool {
+		a, b := analytics.Advertisers[i], analytics.Advertisers[j]
+		if a.ImpressionShare != b.ImpressionShare {
+			return a.ImpressionShare > b.ImpressionShare
+		}
+		return a.Domain < b.Domain
+	})
+
+	return analytics, nil
+}
+
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
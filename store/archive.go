@@ -0,0 +1,102 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RawResponse is a provider response exactly as received for one city of a
+// run. It is stored gzip-compressed.
+type RawResponse struct {
+	RunID     primitive.ObjectID
+	City      string
+	Query     string
+	Device    string
+	Data      []byte
+	FetchedAt time.Time
+}
+
+// archivedResponse is the stored form of a RawResponse.
+type archivedResponse struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	RunID     primitive.ObjectID `bson:"run_id"`
+	City      string             `bson:"city"`
+	Query     string             `bson:"query"`
+	Device    string             `bson:"device"`
+	Encoding  string             `bson:"encoding"`
+	Data      []byte             `bson:"data"`
+	FetchedAt time.Time          `bson:"fetched_at"`
+}
+
+// ArchiveResponse stores response, replacing any earlier one for the same
+// run and city.
+func (s *Store) ArchiveResponse(ctx context.Context, response RawResponse) error {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(response.Data); err != nil {
+		return fmt.Errorf("failed to compress response for city %s: %w", response.City, err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress response for city %s: %w", response.City, err)
+	}
+
+	doc := archivedResponse{
+		RunID:     response.RunID,
+		City:      response.City,
+		Query:     response.Query,
+		Device:    response.Device,
+		Encoding:  "gzip",
+		Data:      compressed.Bytes(),
+		FetchedAt: response.FetchedAt,
+	}
+	filter := bson.M{"run_id": response.RunID, "city": response.City}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := s.archive().ReplaceOne(ctx, filter, doc, opts); err != nil {
+		return fmt.Errorf("failed to archive response for city %s: %w", response.City, err)
+	}
+	return nil
+}
+
+// ArchivedResponses returns the decompressed responses archived for run.
+func (s *Store) ArchivedResponses(ctx context.Context, runID primitive.ObjectID) ([]RawResponse, error) {
+	cursor, err := s.archive().Find(ctx, bson.M{"run_id": runID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archived responses of run %s: %w", runID.Hex(), err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []archivedResponse
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode archived responses: %w", err)
+	}
+
+	responses := make([]RawResponse, 0, len(docs))
+	for _, doc := range docs {
+		zr, err := gzip.NewReader(bytes.NewReader(doc.Data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress response for city %s: %w", doc.City, err)
+		}
+		data, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress response for city %s: %w", doc.City, err)
+		}
+
+		responses = append(responses, RawResponse{
+			RunID:     doc.RunID,
+			City:      doc.City,
+			Query:     doc.Query,
+			Device:    doc.Device,
+			Data:      data,
+			FetchedAt: doc.FetchedAt,
+		})
+	}
+	return responses, nil
+}
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/domains"
+)
+
+const (
+	DefaultChangesLimit = 100
+	MaxChangesLimit     = 1000
+)
+
+type ChangeType string
+
+const (
+	ChangeNewAdvertiser         ChangeType = "new_advertiser"
+	ChangeDisappearedAdvertiser ChangeType = "disappeared_advertiser"
+	ChangeHeadline              ChangeType = "headline_changed"
+	ChangeDescription           ChangeType = "description_changed"
+	ChangeLandingURL            ChangeType = "landing_url_changed"
+)
+
+// AdChange records a difference between the ads an advertiser showed for a
+// query in a city and the ones it showed in the previous run. Before and
+// After hold the changed value; for new and disappeared advertisers they hold
+// the headline.
+type AdChange struct {
+	ID            primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	RunID         primitive.ObjectID `json:"run_id" bson:"run_id"`
+	PreviousRunID primitive.ObjectID `json:"previous_run_id" bson:"previous_run_id"`
+	Tenant        string             `json:"tenant,omitempty" bson:"tenant,omitempty"`
+	Query         string             `json:"query" bson:"query"`
+	City          string             `json:"city" bson:"city"`
+	Device        string             `json:"device" bson:"device"`
+	Advertiser    string             `json:"advertiser" bson:"advertiser"`
+	Type          ChangeType         `json:"type" bson:"type"`
+	Before        string             `json:"before,omitempty" bson:"before,omitempty"`
+	After         string             `json:"after,omitempty" bson:"after,omitempty"`
+	DetectedAt    time.Time          `json:"detected_at" bson:"detected_at"`
+}
+
+// ChangeFilter narrows the changes returned by Changes. Zero values are
+// ignored, except for Tenant which always applies. Query and City match
+// case-insensitive substrings.
+type ChangeFilter struct {
+	Tenant     string
+	Query      string
+	City       string
+	Advertiser string
+	Type       ChangeType
+	RunID      primitive.ObjectID
+	From       time.Time
+	To         time.Time
+	Limit      int
+}
+
+// PreviousObservation returns the latest successful observation of the same
+// query, city and device made by another run of the same tenant before
+// observation, or nil when there is none. Replays are never picked: they
+// differ from their original run only by extraction changes.
+func (s *Store) PreviousObservation(ctx context.Context, observation *Observation) (*Observation, error) {
+	filter := bson.M{
+		"tenant":      tenantScope(observation.Tenant),
+		"replay_of":   nil,
+		"query":       observation.Query,
+		"city":        observation.City,
+		"device":      observation.Device,
+		"status":      ObservationOK,
+		"run_id":      bson.M{"$ne": observation.RunID},
+		"observed_at": bson.M{"$lt": observation.ObservedAt},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "observed_at", Value: -1}})
+
+	var previous Observation
+	err := s.observations().FindOne(ctx, filter, opts).Decode(&previous)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find previous observation for city %s: %w", observation.City, err)
+	}
+	return &previous, nil
+}
+
+// RecordChanges stores changes and fills in their IDs.
+func (s *Store) RecordChanges(ctx context.Context, changes []AdChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(changes))
+	for i := range changes {
+		changes[i].ID = primitive.NewObjectID()
+		docs[i] = changes[i]
+	}
+	if _, err := s.changes().InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to insert ad changes: %w", err)
+	}
+	return nil
+}
+
+// Changes returns the changes matching f, most recent first.
+func (s *Store) Changes(ctx context.Context, f ChangeFilter) ([]AdChange, error) {
+	filter := bson.M{"tenant": tenantScope(f.Tenant)}
+	if f.Query != "" {
+		filter["query"] = containsIgnoreCase(f.Query)
+	}
+	if f.City != "" {
+		filter["city"] = containsIgnoreCase(f.City)
+	}
+	if f.Advertiser != "" {
+		filter["advertiser"] = domains.Registrable(domains.Host(f.Advertiser))
+	}
+	if f.Type != "" {
+		filter["type"] = f.Type
+	}
+	if !f.RunID.IsZero() {
+		filter["run_id"] = f.RunID
+	}
+	if !f.From.IsZero() || !f.To.IsZero() {
+		detectedAt := bson.M{}
+		if !f.From.IsZero() {
+			detectedAt["$gte"] = f.From
+		}
+		if !f.To.IsZero() {
+			detectedAt["$lte"] = f.To
+		}
+		filter["detected_at"] = detectedAt
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = DefaultChangesLimit
+	}
+	if f.Limit > MaxChangesLimit {
+		f.Limit = MaxChangesLimit
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "detected_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(f.Limit))
+
+	cursor, err := s.changes().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ad changes: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	changes := []AdChange{}
+	if err := cursor.All(ctx, &changes); err != nil {
+		return nil, fmt.Errorf("failed to decode ad changes: %w", err)
+	}
+	return changes, nil
+}
+
+// ValidChangeType reports whether t is one of the known change types.
+func ValidChangeType(t ChangeType) bool {
+	switch t {
+	case ChangeNewAdvertiser, ChangeDisappearedAdvertiser, ChangeHeadline, ChangeDescription, ChangeLandingURL:
+		return true
+	}
+	return false
+}
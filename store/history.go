@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/domains"
+)
+
+const (
+	DefaultHistoryLimit = 50
+	MaxHistoryLimit     = 500
+	DefaultHistorySort  = "-observed_at"
+)
+
+// ErrInvalidHistoryQuery is returned for unknown sort fields and malformed
+// or mismatched cursors.
+var ErrInvalidHistoryQuery = errors.New("invalid history query")
+
+// historySortFields maps the sort names accepted by History to document fields.
+var historySortFields = map[string]string{
+	"observed_at": "observed_at",
+	"city":        "city",
+	"query":       "query",
+}
+
+// HistoryFilter narrows the observations returned by History. Zero values
+// are ignored, except for Tenant which always applies. Query and City match
+// case-insensitive substrings. Replays are left out unless IncludeReplays is
+// set or RunID selects one.
+type HistoryFilter struct {
+	Tenant           string
+	Query            string
+	City             string
+	Device           string
+	AdvertiserDomain string
+	From             time.Time
+	To               time.Time
+	RunID            primitive.ObjectID
+	IncludeReplays   bool
+}
+
+type HistoryQuery struct {
+	Filter HistoryFilter
+	// Sort is a field name, optionally prefixed with "-" for descending order.
+	Sort   string
+	Limit  int
+	Cursor string
+}
+
+type HistoryPage struct {
+	Items      []Observation `json:"items"`
+	Total      int64         `json:"total"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// historyCursor is the position after the last item of a page.
+type historyCursor struct {
+	Sort  string             `bson:"s"`
+	Value interface{}        `bson:"v"`
+	ID    primitive.ObjectID `bson:"id"`
+}
+
+// History returns one page of observations matching q, plus the total number
+// of matches regardless of pagination.
+func (s *Store) History(ctx context.Context, q HistoryQuery) (*HistoryPage, error) {
+	if q.Sort == "" {
+		q.Sort = DefaultHistorySort
+	}
+	field, direction, err := parseHistorySort(q.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = DefaultHistoryLimit
+	}
+	if q.Limit > MaxHistoryLimit {
+		q.Limit = MaxHistoryLimit
+	}
+
+	filter := q.Filter.bson()
+
+	total, err := s.observations().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count observations: %w", err)
+	}
+
+	pageFilter := filter
+	if q.Cursor != "" {
+		cursor, err := decodeHistoryCursor(q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Sort != q.Sort {
+			return nil, fmt.Errorf("%w: cursor was issued for sort %q", ErrInvalidHistoryQuery, cursor.Sort)
+		}
+
+		op := "$gt"
+		if direction < 0 {
+			op = "$lt"
+		}
+		pageFilter = bson.M{"$and": bson.A{filter, bson.M{"$or": bson.A{
+			bson.M{field: bson.M{op: cursor.Value}},
+			bson.M{field: cursor.Value, "_id": bson.M{op: cursor.ID}},
+		}}}}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: field, Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(q.Limit + 1))
+
+	found, err := s.observations().Find(ctx, pageFilter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find observations: %w", err)
+	}
+	defer found.Close(ctx)
+
+	page := &HistoryPage{Items: []Observation{}, Total: total}
+	if err := found.All(ctx, &page.Items); err != nil {
+		return nil, fmt.Errorf("failed to decode observations: %w", err)
+	}
+
+	if len(page.Items) > q.Limit {
+		page.Items = page.Items[:q.Limit]
+		last := page.Items[len(page.Items)-1]
+		page.NextCursor, err = encodeHistoryCursor(historyCursor{
+			Sort:  q.Sort,
+			Value: last.sortValue(field),
+			ID:    last.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+func (f HistoryFilter) bson() bson.M {
+	filter := bson.M{"tenant": tenantScope(f.Tenant)}
+	if f.Query != "" {
+		filter["query"] = containsIgnoreCase(f.Query)
+	}
+	if f.City != "" {
+		filter["city"] = containsIgnoreCase(f.City)
+	}
+	if f.Device != "" {
+		filter["device"] = f.Device
+	}
+	if f.AdvertiserDomain != "" {
+		// Runs stored before advertiser domains were normalized hold the
+		// full host, so match both spellings.
+		host := domains.Host(f.AdvertiserDomain)
+		filter["results.serp.advertiser_domain"] = bson.M{"$in": []string{host, domains.Registrable(host)}}
+	}
+	if !f.RunID.IsZero() {
+		filter["run_id"] = f.RunID
+	} else if !f.IncludeReplays {
+		filter["replay_of"] = nil
+	}
+
+	observedAt := bson.M{}
+	if !f.From.IsZero() {
+		observedAt["$gte"] = f.From
+	}
+	if !f.To.IsZero() {
+		observedAt["$lte"] = f.To
+	}
+	if len(observedAt) > 0 {
+		filter["observed_at"] = observedAt
+	}
+	return filter
+}
+
+func containsIgnoreCase(s string) primitive.Regex {
+	return primitive.Regex{Pattern: regexp.QuoteMeta(s), Options: "i"}
+}
+
+func parseHistorySort(sort string) (string, int, error) {
+	direction := 1
+	if strings.HasPrefix(sort, "-") {
+		direction = -1
+		sort = sort[1:]
+	}
+
+	field, ok := historySortFields[sort]
+	if !ok {
+		return "", 0, fmt.Errorf("%w: unknown sort field %q", ErrInvalidHistoryQuery, sort)
+	}
+	return field, direction, nil
+}
+
+func (o Observation) sortValue(field string) interface{} {
+	switch field {
+	case "city":
+		return o.City
+	case "query":
+		return o.Query
+	default:
+		return o.ObservedAt
+	}
+}
+
+func encodeHistoryCursor(c historyCursor) (string, error) {
+	data, err := bson.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode history cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeHistoryCursor(s string) (historyCursor, error) {
+	var c historyCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("%w: malformed cursor", ErrInvalidHistoryQuery)
+	}
+	if err := bson.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("%w: malformed cursor", ErrInvalidHistoryQuery)
+	}
+	return c, nil
+}
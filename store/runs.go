@@ -0,0 +1,335 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/brand"
+	"google-monitoring/domains"
+	"google-monitoring/providers"
+)
+
+type RunStatus string
+
+const (
+	RunRunning   RunStatus = "running"
+	RunCompleted RunStatus = "completed"
+	RunPartial   RunStatus = "partial"
+	RunFailed    RunStatus = "failed"
+	RunCancelled RunStatus = "cancelled"
+)
+
+type ObservationStatus string
+
+const (
+	ObservationOK      ObservationStatus = "ok"
+	ObservationFailed  ObservationStatus = "failed"
+	ObservationSkipped ObservationStatus = "skipped"
+)
+
+// Run is one monitoring execution of a query over a set of cities.
+type Run struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Query      string             `json:"query" bson:"query"`
+	Cities     []string           `json:"cities" bson:"cities"`
+	Device     string             `json:"device" bson:"device"`
+	Requester  string             `json:"requester" bson:"requester"`
+	Tenant     string             `json:"tenant,omitempty" bson:"tenant,omitempty"`
+	StartedAt  time.Time          `json:"started_at" bson:"started_at"`
+	FinishedAt *time.Time         `json:"finished_at,omitempty" bson:"finished_at,omitempty"`
+	Status     RunStatus          `json:"status" bson:"status"`
+	// BrandProfile names the profile the run's ads were classified against.
+	BrandProfile string `json:"brand_profile,omitempty" bson:"brand_profile,omitempty"`
+	// Infringing lists the advertisers found bidding on the brand.
+	Infringing []brand.Advertiser `json:"infringing,omitempty" bson:"infringing,omitempty"`
+	// Enrichers names the enrichers the run asked for; nil means the defaults.
+	Enrichers []string `json:"enrichers" bson:"enrichers"`
+	// Version numbers the runs made from the same SERP responses: 1 for the
+	// original lookup, then one more for every replay of it.
+	Version int `json:"version,omitempty" bson:"version,omitempty"`
+	// ReplayOf is the original run a replay re-processed.
+	ReplayOf *primitive.ObjectID `json:"replay_of,omitempty" bson:"replay_of,omitempty"`
+	// Replays counts the replays of an original run; it allocates their
+	// versions.
+	Replays int `json:"replays,omitempty" bson:"replays,omitempty"`
+	// CancelRequested is set when a cancellation reaches an instance that
+	// is not executing the run; the executing instance polls for it.
+	CancelRequested bool `json:"cancel_requested,omitempty" bson:"cancel_requested,omitempty"`
+}
+
+// Finished reports whether the run has reached a final status.
+func (r *Run) Finished() bool {
+	return r.Status != RunRunning
+}
+
+// Result is a SERP result enriched with the Custom Search listing of its
+// site. Kind tells whether the link was a paid placement or an organic result.
+type Result struct {
+	Title   string               `json:"title" bson:"title"`
+	Snippet string               `json:"snippet" bson:"snippet"`
+	Link    string               `json:"link" bson:"link"`
+	Kind    providers.ResultKind `json:"kind" bson:"kind"`
+	Serp    providers.SerpResult `json:"serp" bson:"serp"`
+	// Brand is the verdict on the advertiser when the run has a brand profile.
+	Brand *brand.Verdict `json:"brand,omitempty" bson:"brand,omitempty"`
+	// Landing is what the landing page showed when the ad was fetched.
+	Landing *LandingPage `json:"landing,omitempty" bson:"landing,omitempty"`
+	// EnrichmentErrors lists the enrichers that failed on this result.
+	EnrichmentErrors []EnrichmentError `json:"enrichment_errors,omitempty" bson:"enrichment_errors,omitempty"`
+}
+
+// LandingPage is the evidence of where an ad led: every redirect hop, the
+// final page and the snapshot of its HTML.
+type LandingPage struct {
+	Hops        []Hop              `json:"hops" bson:"hops"`
+	FinalURL    string             `json:"final_url" bson:"final_url"`
+	Status      int                `json:"status" bson:"status"`
+	Title       string             `json:"title,omitempty" bson:"title,omitempty"`
+	Description string             `json:"description,omitempty" bson:"description,omitempty"`
+	ContentHash string             `json:"content_hash,omitempty" bson:"content_hash,omitempty"`
+	SnapshotID  primitive.ObjectID `json:"snapshot_id,omitempty" bson:"snapshot_id,omitempty"`
+	FetchedAt   time.Time          `json:"fetched_at" bson:"fetched_at"`
+}
+
+// Hop is one response of a redirect chain. Error is set, and Status left
+// at zero, on a hop that was refused without being requested.
+type Hop struct {
+	URL    string `json:"url" bson:"url"`
+	Status int    `json:"status" bson:"status"`
+	Error  string `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// EnrichmentError records an enricher that failed on a result.
+type EnrichmentError struct {
+	Enricher string `json:"enricher" bson:"enricher"`
+	Error    string `json:"error" bson:"error"`
+}
+
+// NewResult returns a result holding what the SERP showed, ready to be
+// enriched.
+func NewResult(serp providers.SerpResult) Result {
+	return Result{
+		Title:   serp.Title,
+		Snippet: serp.Description,
+		Link:    serp.Link,
+		Kind:    serp.Kind,
+		Serp:    serp,
+	}
+}
+
+// Normalize strips tracking parameters and redirects from the links of r and
+// reduces its advertiser to a registrable domain. It is idempotent.
+func (r *Result) Normalize() {
+	r.Link = domains.Clean(r.Link)
+	r.Serp.Link = domains.Clean(r.Serp.Link)
+	for i := range r.Serp.Sitelinks {
+		r.Serp.Sitelinks[i].Link = domains.Clean(r.Serp.Sitelinks[i].Link)
+	}
+
+	switch {
+	case r.Serp.AdvertiserDomain != "":
+		r.Serp.AdvertiserDomain = domains.Registrable(r.Serp.AdvertiserDomain)
+	case r.Serp.Link != "":
+		r.Serp.AdvertiserDomain = domains.Advertiser(r.Serp.Link)
+	default:
+		r.Serp.AdvertiserDomain = domains.Advertiser(r.Serp.DisplayedURL)
+	}
+}
+
+// Observation holds what a run saw for a single city.
+type Observation struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	RunID      primitive.ObjectID `json:"run_id" bson:"run_id"`
+	Tenant     string             `json:"tenant,omitempty" bson:"tenant,omitempty"`
+	Query      string             `json:"query" bson:"query"`
+	City       string             `json:"city" bson:"city"`
+	Device     string             `json:"device" bson:"device"`
+	ObservedAt time.Time          `json:"observed_at" bson:"observed_at"`
+	Status     ObservationStatus  `json:"status" bson:"status"`
+	Error      string             `json:"error,omitempty" bson:"error,omitempty"`
+	// Credits is what the lookup cost with the SERP provider.
+	Credits int `json:"credits" bson:"credits"`
+	// Cached is set when the results came from the SERP cache; AgeSeconds
+	// is then how old they were when observed.
+	Cached     bool `json:"cached,omitempty" bson:"cached,omitempty"`
+	AgeSeconds int  `json:"age_seconds,omitempty" bson:"age_seconds,omitempty"`
+	// ReplayOf is set on the observations of a replay to the original run.
+	// Replays repeat observations already stored, so analytics, history and
+	// change detection leave them out unless asked.
+	ReplayOf *primitive.ObjectID `json:"replay_of,omitempty" bson:"replay_of,omitempty"`
+	Results  []Result            `json:"results" bson:"results"`
+}
+
+// StartRun stores run with a running status and fills in its ID, StartedAt
+// and Version. A replay gets the version after the latest one of its
+// original run.
+func (s *Store) StartRun(ctx context.Context, run *Run) error {
+	run.ID = primitive.NewObjectID()
+	run.StartedAt = time.Now().UTC()
+	run.Status = RunRunning
+	run.Version = 1
+
+	if run.ReplayOf != nil {
+		replays, err := s.nextReplay(ctx, *run.ReplayOf)
+		if err != nil {
+			return err
+		}
+		run.Version = replays + 1
+	}
+
+	if _, err := s.runs().InsertOne(ctx, run); err != nil {
+		return fmt.Errorf("failed to insert run: %w", err)
+	}
+	return nil
+}
+
+// nextReplay increments the replay counter of the original run and returns
+// it, so that replays started at the same time get distinct versions. Runs
+// replayed before the counter existed start it from their stored replays.
+func (s *Store) nextReplay(ctx context.Context, original primitive.ObjectID) (int, error) {
+	existing, err := s.runs().CountDocuments(ctx, bson.M{"replay_of": original})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count replays of run %s: %w", original.Hex(), err)
+	}
+
+	update := bson.A{bson.M{"$set": bson.M{
+		"replays": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$replays", existing}}, 1}},
+	}}}
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"replays": 1})
+
+	var counter struct {
+		Replays int `bson:"replays"`
+	}
+	err = s.runs().FindOneAndUpdate(ctx, bson.M{"_id": original}, update, opts).Decode(&counter)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, ErrRunNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate a replay of run %s: %w", original.Hex(), err)
+	}
+	return counter.Replays, nil
+}
+
+// FinishRun marks run as finished with the given status and stores its
+// infringing advertisers.
+func (s *Store) FinishRun(ctx context.Context, run *Run, status RunStatus) error {
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.Status = status
+
+	update := bson.M{"$set": bson.M{"finished_at": finishedAt, "status": status, "infringing": run.Infringing}}
+	if _, err := s.runs().UpdateByID(ctx, run.ID, update); err != nil {
+		return fmt.Errorf("failed to finish run %s: %w", run.ID.Hex(), err)
+	}
+	return nil
+}
+
+var ErrRunNotFound = errors.New("run not found")
+
+// tenantScope is the filter value selecting the documents of tenant. Data
+// stored before tenants existed has no tenant and only matches "".
+func tenantScope(tenant string) interface{} {
+	if tenant == "" {
+		return nil
+	}
+	return tenant
+}
+
+func (s *Store) GetRun(ctx context.Context, id primitive.ObjectID) (*Run, error) {
+	var run Run
+	err := s.runs().FindOne(ctx, bson.M{"_id": id}).Decode(&run)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find run %s: %w", id.Hex(), err)
+	}
+	return &run, nil
+}
+
+// RequestCancel flags a running run for cancellation. It returns false when
+// the run had already finished.
+func (s *Store) RequestCancel(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	result, err := s.runs().UpdateOne(ctx,
+		bson.M{"_id": id, "status": RunRunning},
+		bson.M{"$set": bson.M{"cancel_requested": true}},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel run %s: %w", id.Hex(), err)
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (s *Store) CancelRequested(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	run, err := s.GetRun(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return run.CancelRequested, nil
+}
+
+// ObservationsForRun returns the observations stored so far for a run.
+func (s *Store) ObservationsForRun(ctx context.Context, runID primitive.ObjectID) ([]Observation, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "observed_at", Value: 1}})
+
+	cursor, err := s.observations().Find(ctx, bson.M{"run_id": runID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find observations of run %s: %w", runID.Hex(), err)
+	}
+	defer cursor.Close(ctx)
+
+	observations := []Observation{}
+	if err := cursor.All(ctx, &observations); err != nil {
+		return nil, fmt.Errorf("failed to decode observations: %w", err)
+	}
+	return observations, nil
+}
+
+// AddObservation links observation to run and stores it.
+func (s *Store) AddObservation(ctx context.Context, run *Run, observation *Observation) error {
+	observation.ID = primitive.NewObjectID()
+	observation.RunID = run.ID
+	observation.Tenant = run.Tenant
+	observation.Query = run.Query
+	observation.Device = run.Device
+	observation.ReplayOf = run.ReplayOf
+	if observation.ObservedAt.IsZero() {
+		observation.ObservedAt = time.Now().UTC()
+	}
+	for i := range observation.Results {
+		observation.Results[i].Normalize()
+	}
+
+	if _, err := s.observations().InsertOne(ctx, observation); err != nil {
+		return fmt.Errorf("failed to insert observation for city %s: %w", observation.City, err)
+	}
+	return s.linkSnapshots(ctx, observation)
+}
+
+// StatusFor derives the final status of a run from its observations.
+func StatusFor(observations []ObservationStatus) RunStatus {
+	ok := 0
+	for _, status := range observations {
+		if status == ObservationOK {
+			ok++
+		}
+	}
+
+	switch {
+	case ok == len(observations):
+		return RunCompleted
+	case ok == 0:
+		return RunFailed
+	default:
+		return RunPartial
+	}
+}
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const snapshotsBucket = "snapshots"
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Snapshot is the HTML a landing page served when it was fetched.
+type Snapshot struct {
+	URL         string
+	ContentHash string
+	HTML        []byte
+	FetchedAt   time.Time
+}
+
+// snapshotMetadata is kept with every GridFS file of the snapshots bucket.
+type snapshotMetadata struct {
+	URL             string               `bson:"url"`
+	ContentHash     string               `bson:"content_hash"`
+	ContentEncoding string               `bson:"content_encoding"`
+	FetchedAt       time.Time            `bson:"fetched_at"`
+	ObservationIDs  []primitive.ObjectID `bson:"observation_ids"`
+}
+
+// snapshots returns a bucket whose operations end at the deadline of ctx.
+func (s *Store) snapshots(ctx context.Context) (*gridfs.Bucket, error) {
+	bucket, err := gridfs.NewBucket(s.db, options.GridFSBucket().SetName(snapshotsBucket))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshots bucket: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		bucket.SetWriteDeadline(deadline)
+		bucket.SetReadDeadline(deadline)
+	}
+	return bucket, nil
+}
+
+// SaveSnapshot stores snapshot gzip-compressed in GridFS and returns its ID.
+// Pages with the same content hash are stored once.
+func (s *Store) SaveSnapshot(ctx context.Context, snapshot Snapshot) (primitive.ObjectID, error) {
+	bucket, err := s.snapshots(ctx)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	var existing struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	err = bucket.GetFilesCollection().FindOne(ctx, bson.M{"metadata.content_hash": snapshot.ContentHash}).Decode(&existing)
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return primitive.NilObjectID, fmt.Errorf("failed to look up snapshot: %w", err)
+	}
+
+	compressed, err := compress(snapshot.HTML)
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+
+	metadata := snapshotMetadata{
+		URL:             snapshot.URL,
+		ContentHash:     snapshot.ContentHash,
+		ContentEncoding: "gzip",
+		FetchedAt:       snapshot.FetchedAt,
+		ObservationIDs:  []primitive.ObjectID{},
+	}
+	id, err := bucket.UploadFromStream(snapshot.ContentHash+".html.gz", bytes.NewReader(compressed), options.GridFSUpload().SetMetadata(metadata))
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("failed to upload snapshot: %w", err)
+	}
+	return id, nil
+}
+
+// Snapshot returns the decompressed HTML of the snapshot with id. Snapshots
+// are shared between tenants with the same page, so tenant only sees the
+// ones its own observations point to.
+func (s *Store) Snapshot(ctx context.Context, tenant string, id primitive.ObjectID) (*Snapshot, error) {
+	seen, err := s.observations().CountDocuments(ctx, bson.M{
+		"tenant":                      tenantScope(tenant),
+		"results.landing.snapshot_id": id,
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check access to snapshot %s: %w", id.Hex(), err)
+	}
+	if seen == 0 {
+		return nil, ErrSnapshotNotFound
+	}
+
+	bucket, err := s.snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Metadata snapshotMetadata `bson:"metadata"`
+	}
+	err = bucket.GetFilesCollection().FindOne(ctx, bson.M{"_id": id}).Decode(&file)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find snapshot %s: %w", id.Hex(), err)
+	}
+
+	var compressed bytes.Buffer
+	if _, err := bucket.DownloadToStream(id, &compressed); err != nil {
+		return nil, fmt.Errorf("failed to download snapshot %s: %w", id.Hex(), err)
+	}
+	html, err := decompress(compressed.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot %s: %w", id.Hex(), err)
+	}
+
+	return &Snapshot{
+		URL:         file.Metadata.URL,
+		ContentHash: file.Metadata.ContentHash,
+		HTML:        html,
+		FetchedAt:   file.Metadata.FetchedAt,
+	}, nil
+}
+
+// compress gzips the HTML of a snapshot as it is kept in GridFS.
+func compress(html []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(html); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(zr)
+}
+
+// linkSnapshots records observation on the snapshots its results point to.
+func (s *Store) linkSnapshots(ctx context.Context, observation *Observation) error {
+	var ids []primitive.ObjectID
+	for _, result := range observation.Results {
+		if result.Landing != nil && !result.Landing.SnapshotID.IsZero() {
+			ids = append(ids, result.Landing.SnapshotID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	bucket, err := s.snapshots(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = bucket.GetFilesCollection().UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$addToSet": bson.M{"metadata.observation_ids": observation.ID}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to link snapshots to observation %s: %w", observation.ID.Hex(), err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSnapshotCompression(t *testing.T) {
+	html := bytes.Repeat([]byte("<p>Tênis de corrida com frete grátis</p>\n"), 200)
+
+	compressed, err := compress(html)
+	if err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if !bytes.HasPrefix(compressed, []byte{0x1f, 0x8b}) {
+		t.Errorf("snapshot is not stored as gzip: % x", compressed[:2])
+	}
+	if len(compressed) >= len(html) {
+		t.Errorf("compressed snapshot is %d bytes, page is %d", len(compressed), len(html))
+	}
+
+	got, err := decompress(compressed)
+	if err != nil {
+		t.Fatalf("decompress: %v", err)
+	}
+	if !bytes.Equal(got, html) {
+		t.Error("decompressed snapshot differs from the page")
+	}
+
+	if _, err := decompress(html); err == nil {
+		t.Error("decompress accepted data that is not gzip")
+	}
+}
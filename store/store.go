@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	runsCollection         = "runs"
+	observationsCollection = "observations"
+	changesCollection      = "ad_changes"
+	archiveCollection      = "serp_archive"
+)
+
+// Store persists monitoring runs and their observations in MongoDB.
+type Store struct {
+	db *mongo.Database
+}
+
+func New(client *mongo.Client, dbName string) *Store {
+	return &Store{db: client.Database(dbName)}
+}
+
+func (s *Store) runs() *mongo.Collection {
+	return s.db.Collection(runsCollection)
+}
+
+func (s *Store) observations() *mongo.Collection {
+	return s.db.Collection(observationsCollection)
+}
+
+func (s *Store) changes() *mongo.Collection {
+	return s.db.Collection(changesCollection)
+}
+
+func (s *Store) archive() *mongo.Collection {
+	return s.db.Collection(archiveCollection)
+}
+
+// EnsureIndexes creates the indexes the history and audit queries rely on.
+// It is safe to call on every startup.
+func (s *Store) EnsureIndexes(ctx context.Context) error {
+	indexes := map[*mongo.Collection][]mongo.IndexModel{
+		s.runs(): {
+			{Keys: bson.D{{Key: "started_at", Value: -1}}},
+			{Keys: bson.D{{Key: "query", Value: 1}, {Key: "started_at", Value: -1}}},
+			{Keys: bson.D{{Key: "status", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "started_at", Value: -1}}},
+			{Keys: bson.D{{Key: "replay_of", Value: 1}}, Options: options.Index().SetSparse(true)},
+		},
+		s.observations(): {
+			{Keys: bson.D{{Key: "run_id", Value: 1}}},
+			{Keys: bson.D{{Key: "observed_at", Value: -1}}},
+			{Keys: bson.D{{Key: "query", Value: 1}, {Key: "city", Value: 1}, {Key: "observed_at", Value: -1}}},
+			{Keys: bson.D{{Key: "city", Value: 1}, {Key: "observed_at", Value: -1}}},
+			{Keys: bson.D{{Key: "device", Value: 1}, {Key: "observed_at", Value: -1}}},
+			{Keys: bson.D{{Key: "results.serp.advertiser_domain", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "observed_at", Value: -1}}},
+			{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "results.landing.snapshot_id", Value: 1}}},
+		},
+		s.db.Collection(snapshotsBucket + ".files"): {
+			{Keys: bson.D{{Key: "metadata.content_hash", Value: 1}}},
+		},
+		s.archive(): {
+			{Keys: bson.D{{Key: "run_id", Value: 1}, {Key: "city", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		s.changes(): {
+			{Keys: bson.D{{Key: "run_id", Value: 1}}},
+			{Keys: bson.D{{Key: "detected_at", Value: -1}}},
+			{Keys: bson.D{{Key: "query", Value: 1}, {Key: "city", Value: 1}, {Key: "detected_at", Value: -1}}},
+			{Keys: bson.D{{Key: "advertiser", Value: 1}, {Key: "detected_at", Value: -1}}},
+			{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "detected_at", Value: -1}}},
+		},
+	}
+
+	for collection, models := range indexes {
+		if _, err := collection.Indexes().CreateMany(ctx, models, options.CreateIndexes()); err != nil {
+			return fmt.Errorf("failed to create indexes on %s: %w", collection.Name(), err)
+		}
+	}
+	return nil
+}
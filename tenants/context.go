@@ -0,0 +1,25 @@
+package tenants
+
+import "context"
+
+type contextKey struct{}
+
+// WithTenant returns a context carrying the authenticated tenant t.
+func WithTenant(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the authenticated tenant of ctx, or nil.
+func FromContext(ctx context.Context) *Tenant {
+	t, _ := ctx.Value(contextKey{}).(*Tenant)
+	return t
+}
+
+// NameFromContext returns the name of the authenticated tenant of ctx, or ""
+// when there is none.
+func NameFromContext(ctx context.Context) string {
+	if t := FromContext(ctx); t != nil {
+		return t.Name
+	}
+	return ""
+}
@@ -0,0 +1,232 @@
+// Package tenants keeps the clients served by a deployment and the API keys
+// they authenticate with. Every stored run, job, brand profile, city group
+// and schedule belongs to one tenant.
+package tenants
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google-monitoring/access"
+)
+
+const tenantsCollection = "tenants"
+
+// keyPrefix starts every API key so leaked keys are easy to spot.
+const keyPrefix = "gm_"
+
+var (
+	ErrNotFound     = errors.New("tenant not found")
+	ErrInvalidKey   = errors.New("invalid API key")
+	ErrDisabled     = errors.New("tenant is disabled")
+	ErrKeyNotFound  = errors.New("API key not found")
+	validTenantName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+)
+
+// ValidationError reports an invalid tenant.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Tenant is a client of the deployment. Name is the identifier stored on
+// its data.
+type Tenant struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name      string             `json:"name" bson:"name"`
+	Disabled  bool               `json:"disabled" bson:"disabled"`
+	Keys      []APIKey           `json:"keys" bson:"keys"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// APIKey is a key of a tenant. Only a hash of the key is stored; Prefix
+// helps telling keys apart. Keys issued before roles existed are analysts.
+type APIKey struct {
+	ID        primitive.ObjectID `json:"id" bson:"id"`
+	Label     string             `json:"label" bson:"label"`
+	Role      access.Role        `json:"role" bson:"role"`
+	Prefix    string             `json:"prefix" bson:"prefix"`
+	Hash      string             `json:"-" bson:"hash"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// Store persists tenants in MongoDB. Tenant names and key hashes are unique.
+type Store struct {
+	collection *mongo.Collection
+}
+
+func NewStore(client *mongo.Client, dbName string) *Store {
+	return &Store{collection: client.Database(dbName).Collection(tenantsCollection)}
+}
+
+func (st *Store) EnsureIndexes(ctx context.Context) error {
+	_, err := st.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "keys.hash", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", tenantsCollection, err)
+	}
+	return nil
+}
+
+func (st *Store) List(ctx context.Context) ([]Tenant, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := st.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tenants: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	list := []Tenant{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode tenants: %w", err)
+	}
+	return list, nil
+}
+
+func (st *Store) Get(ctx context.Context, name string) (*Tenant, error) {
+	var t Tenant
+	err := st.collection.FindOne(ctx, bson.M{"name": name}).Decode(&t)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tenant %q: %w", name, err)
+	}
+	return &t, nil
+}
+
+// Create stores a tenant called name, without keys.
+func (st *Store) Create(ctx context.Context, name string) (*Tenant, error) {
+	if !validTenantName.MatchString(name) {
+		return nil, &ValidationError{Field: "name", Message: "must be lower-case letters, digits, '-' or '_'"}
+	}
+
+	t := &Tenant{ID: primitive.NewObjectID(), Name: name, Keys: []APIKey{}, CreatedAt: time.Now().UTC()}
+	_, err := st.collection.InsertOne(ctx, t)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, &ValidationError{Field: "name", Message: fmt.Sprintf("a tenant named %q already exists", name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert tenant: %w", err)
+	}
+	return t, nil
+}
+
+// SetDisabled disables or re-enables the tenant called name.
+func (st *Store) SetDisabled(ctx context.Context, name string, disabled bool) error {
+	result, err := st.collection.UpdateOne(ctx, bson.M{"name": name}, bson.M{"$set": bson.M{"disabled": disabled}})
+	if err != nil {
+		return fmt.Errorf("failed to update tenant %q: %w", name, err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// IssueKey creates an API key with role for the tenant called name. The key
+// itself is returned only here.
+func (st *Store) IssueKey(ctx context.Context, name, label string, role access.Role) (string, *APIKey, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key := keyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey := &APIKey{
+		ID:        primitive.NewObjectID(),
+		Label:     label,
+		Role:      role,
+		Prefix:    key[:len(keyPrefix)+6],
+		Hash:      hashKey(key),
+		CreatedAt: time.Now().UTC(),
+	}
+	result, err := st.collection.UpdateOne(ctx, bson.M{"name": name}, bson.M{"$push": bson.M{"keys": apiKey}})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to store API key of tenant %q: %w", name, err)
+	}
+	if result.MatchedCount == 0 {
+		return "", nil, ErrNotFound
+	}
+	return key, apiKey, nil
+}
+
+// RevokeKey deletes the key with id from the tenant called name.
+func (st *Store) RevokeKey(ctx context.Context, name string, id primitive.ObjectID) error {
+	result, err := st.collection.UpdateOne(ctx,
+		bson.M{"name": name, "keys.id": id},
+		bson.M{"$pull": bson.M{"keys": bson.M{"id": id}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key of tenant %q: %w", name, err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// SetKeyRole changes the role of the key with id of the tenant called name.
+func (st *Store) SetKeyRole(ctx context.Context, name string, id primitive.ObjectID, role access.Role) error {
+	result, err := st.collection.UpdateOne(ctx,
+		bson.M{"name": name, "keys.id": id},
+		bson.M{"$set": bson.M{"keys.$.role": role}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to change the role of API key %s: %w", id.Hex(), err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// Authenticate returns the enabled tenant owning key, and the stored key.
+func (st *Store) Authenticate(ctx context.Context, key string) (*Tenant, *APIKey, error) {
+	hash := hashKey(key)
+
+	var t Tenant
+	err := st.collection.FindOne(ctx, bson.M{"keys.hash": hash}).Decode(&t)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+	if t.Disabled {
+		return nil, nil, ErrDisabled
+	}
+
+	for i := range t.Keys {
+		if t.Keys[i].Hash == hash {
+			return &t, &t.Keys[i], nil
+		}
+	}
+	return nil, nil, ErrInvalidKey
+}
+
+// hashKey hashes an API key for storage. Keys are 256 random bits, so a
+// plain SHA-256 is enough.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
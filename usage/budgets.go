@@ -0,0 +1,110 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const budgetsCollection = "credit_budgets"
+
+var ErrInvalidBudget = errors.New("budgets must not be negative")
+
+// ErrBudgetAboveCeiling is returned when an override would raise or remove
+// a budget the operator configured. Only the operator can do that.
+var ErrBudgetAboveCeiling = errors.New("budget exceeds the configured ceiling")
+
+// Budget overrides the configured budgets of a tenant. A nil period keeps
+// the configured budget and zero makes it unlimited. The configured budgets
+// are ceilings: an override can only lower them, or set a budget for a
+// period the operator left unlimited.
+type Budget struct {
+	Daily   *int `json:"daily" bson:"daily"`
+	Monthly *int `json:"monthly" bson:"monthly"`
+}
+
+// BudgetSettings is the budget of a tenant as served by /usage/budget:
+// Daily and Monthly are in force, Override is what an admin set.
+type BudgetSettings struct {
+	Tenant    string     `json:"tenant"`
+	Daily     int        `json:"daily"`
+	Monthly   int        `json:"monthly"`
+	Override  Budget     `json:"override"`
+	UpdatedBy string     `json:"updated_by,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// budgetOverride is the stored override of a tenant.
+type budgetOverride struct {
+	Tenant    string    `bson:"tenant"`
+	Budget    Budget    `bson:"budget"`
+	UpdatedBy string    `bson:"updated_by"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// Budget returns the budgets of tenant.
+func (l *Ledger) Budget(ctx context.Context, tenant string) (*BudgetSettings, error) {
+	settings := &BudgetSettings{Tenant: tenant}
+	settings.Daily, settings.Monthly = l.budgets.For(tenant)
+
+	var override budgetOverride
+	err := l.overrides.FindOne(ctx, bson.M{"tenant": tenant}).Decode(&override)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the budget of tenant %q: %w", tenant, err)
+	}
+
+	settings.Override = override.Budget
+	settings.UpdatedBy = override.UpdatedBy
+	settings.UpdatedAt = &override.UpdatedAt
+	// Overrides stored before the operator lowered a budget stay capped.
+	settings.Daily = capped(override.Budget.Daily, settings.Daily)
+	settings.Monthly = capped(override.Budget.Monthly, settings.Monthly)
+	return settings, nil
+}
+
+// SetBudget stores budget as the override of tenant, on behalf of actor.
+// It fails with ErrBudgetAboveCeiling when budget would go over, or remove,
+// a budget configured for tenant.
+func (l *Ledger) SetBudget(ctx context.Context, tenant string, budget Budget, actor string) (*BudgetSettings, error) {
+	for _, credits := range []*int{budget.Daily, budget.Monthly} {
+		if credits != nil && *credits < 0 {
+			return nil, ErrInvalidBudget
+		}
+	}
+	daily, monthly := l.budgets.For(tenant)
+	if exceeds(budget.Daily, daily) {
+		return nil, fmt.Errorf("%w: the daily budget cannot exceed %d credits", ErrBudgetAboveCeiling, daily)
+	}
+	if exceeds(budget.Monthly, monthly) {
+		return nil, fmt.Errorf("%w: the monthly budget cannot exceed %d credits", ErrBudgetAboveCeiling, monthly)
+	}
+
+	override := budgetOverride{Tenant: tenant, Budget: budget, UpdatedBy: actor, UpdatedAt: time.Now().UTC()}
+	_, err := l.overrides.ReplaceOne(ctx, bson.M{"tenant": tenant}, override, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to store the budget of tenant %q: %w", tenant, err)
+	}
+	return l.Budget(ctx, tenant)
+}
+
+// capped returns the budget in force for an override of ceiling.
+func capped(override *int, ceiling int) int {
+	if override == nil || exceeds(override, ceiling) {
+		return ceiling
+	}
+	return *override
+}
+
+// exceeds reports whether override raises or removes ceiling. A zero ceiling
+// is unlimited and accepts any override.
+func exceeds(override *int, ceiling int) bool {
+	return override != nil && ceiling > 0 && (*override == 0 || *override > ceiling)
+}
@@ -0,0 +1,68 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func credits(n int) *int {
+	return &n
+}
+
+func TestCapped(t *testing.T) {
+	tests := []struct {
+		name     string
+		override *int
+		ceiling  int
+		want     int
+	}{
+		{"no override", nil, 100, 100},
+		{"lower", credits(40), 100, 40},
+		{"equal", credits(100), 100, 100},
+		{"higher", credits(500), 100, 100},
+		{"unlimited", credits(0), 100, 100},
+		{"no ceiling", credits(500), 0, 500},
+		{"unlimited without ceiling", credits(0), 0, 0},
+	}
+	for _, tt := range tests {
+		if got := capped(tt.override, tt.ceiling); got != tt.want {
+			t.Errorf("%s: capped = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestSetBudgetRejectsAboveCeiling needs no database: the budget is refused
+// before anything is stored.
+func TestSetBudgetRejectsAboveCeiling(t *testing.T) {
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ledger := NewLedger(client, "usage_test", Budgets{
+		Daily:         100,
+		TenantMonthly: map[string]int{"acme": 2000},
+	})
+
+	tests := []struct {
+		name   string
+		tenant string
+		budget Budget
+		want   error
+	}{
+		{"negative", "acme", Budget{Daily: credits(-1)}, ErrInvalidBudget},
+		{"daily above the default", "acme", Budget{Daily: credits(101)}, ErrBudgetAboveCeiling},
+		{"daily unlimited", "acme", Budget{Daily: credits(0)}, ErrBudgetAboveCeiling},
+		{"monthly above the tenant budget", "acme", Budget{Monthly: credits(2001)}, ErrBudgetAboveCeiling},
+		{"monthly unlimited", "acme", Budget{Monthly: credits(0)}, ErrBudgetAboveCeiling},
+	}
+	for _, tt := range tests {
+		_, err := ledger.SetBudget(context.Background(), tt.tenant, tt.budget, "admin@acme")
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: SetBudget error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Package usage keeps the ledger of SerpAPI credits spent by every tenant
+// and enforces their daily and monthly budgets.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const ledgerCollection = "credit_ledger"
+
+// dayLayout keys ledger entries by UTC day; it sorts like the dates it holds.
+const dayLayout = time.DateOnly
+
+// Periods a budget applies to.
+const (
+	Daily   = "daily"
+	Monthly = "monthly"
+)
+
+// Budgets caps the credits a tenant may spend per day and per month. Zero
+// means unlimited; the tenant maps override the defaults. Budgets set
+// through Ledger.SetBudget may lower both but never go over them.
+type Budgets struct {
+	Daily         int
+	Monthly       int
+	TenantDaily   map[string]int
+	TenantMonthly map[string]int
+}
+
+// For returns the daily and monthly budgets of tenant.
+func (b Budgets) For(tenant string) (daily, monthly int) {
+	daily, monthly = b.Daily, b.Monthly
+	if budget, ok := b.TenantDaily[tenant]; ok {
+		daily = budget
+	}
+	if budget, ok := b.TenantMonthly[tenant]; ok {
+		monthly = budget
+	}
+	return daily, monthly
+}
+
+// BudgetExceededError is returned when spending more credits would go over a
+// budget of the tenant.
+type BudgetExceededError struct {
+	Tenant    string
+	Period    string
+	Budget    int
+	Spent     int
+	Requested int
+	ResetAt   time.Time
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("%s credit budget of %d exceeded: %d spent, %d more requested; it resets at %s",
+		e.Period, e.Budget, e.Spent, e.Requested, e.ResetAt.Format(time.RFC3339))
+}
+
+// entry is the credits a tenant spent on one run in one day.
+type entry struct {
+	Tenant    string             `bson:"tenant"`
+	RunID     primitive.ObjectID `bson:"run_id"`
+	Day       string             `bson:"day"`
+	Credits   int                `bson:"credits"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// Ledger records credits in MongoDB, one document per tenant, run and day.
+type Ledger struct {
+	collection *mongo.Collection
+	overrides  *mongo.Collection
+	counters   *mongo.Collection
+	budgets    Budgets
+}
+
+func NewLedger(client *mongo.Client, dbName string, budgets Budgets) *Ledger {
+	db := client.Database(dbName)
+	return &Ledger{
+		collection: db.Collection(ledgerCollection),
+		overrides:  db.Collection(budgetsCollection),
+		counters:   db.Collection(countersCollection),
+		budgets:    budgets,
+	}
+}
+
+func (l *Ledger) EnsureIndexes(ctx context.Context) error {
+	_, err := l.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tenant", Value: 1}, {Key: "run_id", Value: 1}, {Key: "day", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "tenant", Value: 1}, {Key: "day", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", ledgerCollection, err)
+	}
+
+	_, err = l.overrides.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tenant", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", budgetsCollection, err)
+	}
+
+	_, err = l.counters.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tenant", Value: 1}, {Key: "period", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", countersCollection, err)
+	}
+	return nil
+}
+
+// Record adds credits spent by tenant on run today.
+func (l *Ledger) Record(ctx context.Context, tenant string, runID primitive.ObjectID, credits int) error {
+	if credits <= 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	_, err := l.collection.UpdateOne(ctx,
+		bson.M{"tenant": tenant, "run_id": runID, "day": now.Format(dayLayout)},
+		bson.M{"$inc": bson.M{"credits": credits}, "$set": bson.M{"updated_at": now}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record %d credits of run %s: %w", credits, runID.Hex(), err)
+	}
+	return nil
+}
+
+// Spent returns the credits tenant spent from the day of from to the day of
+// to, both included.
+func (l *Ledger) Spent(ctx context.Context, tenant string, from, to time.Time) (int, error) {
+	cursor, err := l.collection.Aggregate(ctx, bson.A{
+		bson.M{"$match": dayRange(tenant, from, to)},
+		bson.M{"$group": bson.M{"_id": nil, "credits": bson.M{"$sum": "$credits"}}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum credits: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var totals []struct {
+		Credits int `bson:"credits"`
+	}
+	if err := cursor.All(ctx, &totals); err != nil {
+		return 0, fmt.Errorf("failed to decode credits: %w", err)
+	}
+	if len(totals) == 0 {
+		return 0, nil
+	}
+	return totals[0].Credits, nil
+}
+
+// Check returns a *BudgetExceededError when tenant cannot spend credits more
+// today without going over its daily or monthly budget. It only reads the
+// ledger, to turn down requests early; lookups take their credits with
+// Reserve.
+func (l *Ledger) Check(ctx context.Context, tenant string, credits int) error {
+	now := time.Now().UTC()
+	settings, err := l.Budget(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	budgets := map[string]int{Daily: settings.Daily, Monthly: settings.Monthly}
+
+	for _, period := range []string{Daily, Monthly} {
+		budget := budgets[period]
+		if budget <= 0 {
+			continue
+		}
+
+		start, reset := periodBounds(now, period)
+		spent, err := l.Spent(ctx, tenant, start, now)
+		if err != nil {
+			return err
+		}
+		if spent+credits > budget {
+			return &BudgetExceededError{
+				Tenant:    tenant,
+				Period:    period,
+				Budget:    budget,
+				Spent:     spent,
+				Requested: credits,
+				ResetAt:   reset,
+			}
+		}
+	}
+	return nil
+}
+
+// periodBounds returns the UTC start of the day or month holding t and the
+// start of the next one, when its budget resets.
+func periodBounds(t time.Time, period string) (start, reset time.Time) {
+	t = t.UTC()
+	if period == Monthly {
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 1)
+}
+
+func dayRange(tenant string, from, to time.Time) bson.M {
+	return bson.M{
+		"tenant": tenant,
+		"day":    bson.M{"$gte": from.UTC().Format(dayLayout), "$lte": to.UTC().Format(dayLayout)},
+	}
+}
@@ -0,0 +1,119 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Report is the usage of a tenant as served by GET /usage.
+type Report struct {
+	Tenant  string     `json:"tenant"`
+	From    string     `json:"from"`
+	To      string     `json:"to"`
+	Credits int        `json:"credits"`
+	Daily   Period     `json:"daily"`
+	Monthly Period     `json:"monthly"`
+	Days    []DayUsage `json:"days"`
+	Jobs    []JobUsage `json:"jobs"`
+}
+
+// Period is the state of one budget. Budget and Remaining are omitted when
+// the budget is unlimited.
+type Period struct {
+	Spent     int       `json:"spent"`
+	Budget    int       `json:"budget,omitempty"`
+	Remaining *int      `json:"remaining,omitempty"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+type DayUsage struct {
+	Day     string `json:"day" bson:"day"`
+	Credits int    `json:"credits" bson:"credits"`
+}
+
+type JobUsage struct {
+	JobID   primitive.ObjectID `json:"job_id"`
+	Credits int                `json:"credits"`
+}
+
+// Report returns the credits tenant spent between from and to, by day and by
+// job, along with the state of its current budgets.
+func (l *Ledger) Report(ctx context.Context, tenant string, from, to time.Time) (*Report, error) {
+	report := &Report{
+		Tenant: tenant,
+		From:   from.UTC().Format(dayLayout),
+		To:     to.UTC().Format(dayLayout),
+		Days:   []DayUsage{},
+		Jobs:   []JobUsage{},
+	}
+
+	if err := l.group(ctx, tenant, from, to, "$day", &report.Days); err != nil {
+		return nil, err
+	}
+	var jobs []struct {
+		ID      primitive.ObjectID `bson:"_id"`
+		Credits int                `bson:"credits"`
+	}
+	if err := l.group(ctx, tenant, from, to, "$run_id", &jobs); err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		report.Jobs = append(report.Jobs, JobUsage{JobID: job.ID, Credits: job.Credits})
+	}
+	for _, day := range report.Days {
+		report.Credits += day.Credits
+	}
+
+	now := time.Now().UTC()
+	settings, err := l.Budget(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if report.Daily, err = l.period(ctx, tenant, Daily, settings.Daily, now); err != nil {
+		return nil, err
+	}
+	if report.Monthly, err = l.period(ctx, tenant, Monthly, settings.Monthly, now); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// group sums the credits of tenant between from and to by field into out,
+// sorted by field.
+func (l *Ledger) group(ctx context.Context, tenant string, from, to time.Time, field string, out interface{}) error {
+	cursor, err := l.collection.Aggregate(ctx, bson.A{
+		bson.M{"$match": dayRange(tenant, from, to)},
+		bson.M{"$group": bson.M{"_id": field, "credits": bson.M{"$sum": "$credits"}}},
+		bson.M{"$sort": bson.M{"_id": 1}},
+		bson.M{"$project": bson.M{"_id": 1, "day": "$_id", "credits": 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to aggregate credits: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, out); err != nil {
+		return fmt.Errorf("failed to decode credits: %w", err)
+	}
+	return nil
+}
+
+func (l *Ledger) period(ctx context.Context, tenant, name string, budget int, now time.Time) (Period, error) {
+	start, reset := periodBounds(now, name)
+	spent, err := l.Spent(ctx, tenant, start, now)
+	if err != nil {
+		return Period{}, err
+	}
+
+	p := Period{Spent: spent, ResetAt: reset}
+	if budget > 0 {
+		remaining := max(budget-spent, 0)
+		p.Budget = budget
+		p.Remaining = &remaining
+	}
+	return p, nil
+}
@@ -0,0 +1,143 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const countersCollection = "credit_counters"
+
+// counter is the credits a tenant has spent or reserved in one day or
+// month. Reserve draws from counters with a conditional update, so that
+// concurrent lookups cannot all pass the budget check before any of them
+// is recorded.
+type counter struct {
+	Tenant    string    `bson:"tenant"`
+	Period    string    `bson:"period"`
+	Credits   int       `bson:"credits"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// Reservation holds credits taken from the budgets of a tenant until the
+// lookup they were reserved for is settled.
+type Reservation struct {
+	tenant  string
+	credits int
+	periods []string
+}
+
+// counterKey names the counter of the day or month starting at start.
+func counterKey(period string, start time.Time) string {
+	if period == Monthly {
+		return "month:" + start.Format("2006-01")
+	}
+	return "day:" + start.Format(dayLayout)
+}
+
+// Reserve takes credits from the daily and monthly budgets of tenant, or
+// returns a *BudgetExceededError, taking nothing, when either cannot afford
+// them. Reserved credits count as spent until Settle.
+func (l *Ledger) Reserve(ctx context.Context, tenant string, credits int) (*Reservation, error) {
+	now := time.Now().UTC()
+	settings, err := l.Budget(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	budgets := map[string]int{Daily: settings.Daily, Monthly: settings.Monthly}
+
+	reservation := &Reservation{tenant: tenant, credits: credits}
+	for _, period := range []string{Daily, Monthly} {
+		start, reset := periodBounds(now, period)
+		key := counterKey(period, start)
+
+		spent, ok, err := l.take(ctx, tenant, key, start, now, credits, budgets[period])
+		if err == nil && !ok {
+			err = &BudgetExceededError{
+				Tenant:    tenant,
+				Period:    period,
+				Budget:    budgets[period],
+				Spent:     spent,
+				Requested: credits,
+				ResetAt:   reset,
+			}
+		}
+		if err != nil {
+			l.release(context.WithoutCancel(ctx), reservation, credits)
+			return nil, err
+		}
+		reservation.periods = append(reservation.periods, key)
+	}
+	return reservation, nil
+}
+
+// take adds credits to the counter called key unless that would take it
+// over budget, zero being unlimited. It reports whether they were taken
+// and, when not, the credits already counted. A missing counter is started
+// from the ledger entries since start.
+func (l *Ledger) take(ctx context.Context, tenant, key string, start, now time.Time, credits, budget int) (int, bool, error) {
+	filter := bson.M{"tenant": tenant, "period": key}
+	if budget > 0 {
+		filter["credits"] = bson.M{"$lte": budget - credits}
+	}
+	update := bson.M{"$inc": bson.M{"credits": credits}, "$set": bson.M{"updated_at": now}}
+
+	for attempt := 0; ; attempt++ {
+		result, err := l.counters.UpdateOne(ctx, filter, update)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to reserve credits of tenant %q: %w", tenant, err)
+		}
+		if result.MatchedCount > 0 {
+			return 0, true, nil
+		}
+
+		var current counter
+		err = l.counters.FindOne(ctx, bson.M{"tenant": tenant, "period": key}).Decode(&current)
+		if err == nil {
+			return current.Credits, false, nil
+		}
+		if !errors.Is(err, mongo.ErrNoDocuments) || attempt > 0 {
+			return 0, false, fmt.Errorf("failed to read the credit counter of tenant %q: %w", tenant, err)
+		}
+
+		// Concurrent first lookups may both start the counter; the unique
+		// index keeps one and the other just retries.
+		spent, err := l.Spent(ctx, tenant, start, now)
+		if err != nil {
+			return 0, false, err
+		}
+		_, err = l.counters.InsertOne(ctx, counter{Tenant: tenant, Period: key, Credits: spent, UpdatedAt: now})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return 0, false, fmt.Errorf("failed to start the credit counter of tenant %q: %w", tenant, err)
+		}
+	}
+}
+
+// Settle records the credits actually spent on run with reservation, which
+// may be fewer than were reserved, and gives the rest back to the budgets.
+func (l *Ledger) Settle(ctx context.Context, reservation *Reservation, runID primitive.ObjectID, spent int) error {
+	if err := l.Record(ctx, reservation.tenant, runID, spent); err != nil {
+		return err
+	}
+	return l.release(ctx, reservation, reservation.credits-spent)
+}
+
+// release gives credits of reservation back to the counters it drew from.
+func (l *Ledger) release(ctx context.Context, reservation *Reservation, credits int) error {
+	if credits == 0 || len(reservation.periods) == 0 {
+		return nil
+	}
+	_, err := l.counters.UpdateMany(ctx,
+		bson.M{"tenant": reservation.tenant, "period": bson.M{"$in": reservation.periods}},
+		bson.M{"$inc": bson.M{"credits": -credits}, "$set": bson.M{"updated_at": time.Now().UTC()}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release %d credits of tenant %q: %w", credits, reservation.tenant, err)
+	}
+	return nil
+}